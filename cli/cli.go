@@ -6,6 +6,7 @@ import (
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/cache"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
 	"github.com/figment-networks/oasishub-indexer/utils/reporting"
 	"github.com/pkg/errors"
@@ -20,6 +21,14 @@ type Flags struct {
 	batchSize  int64
 	parallel   bool
 	force      bool
+
+	table string
+	from  string
+	to    string
+
+	name      string
+	scopes    string
+	rateLimit int64
 }
 
 func (c *Flags) Setup() {
@@ -31,6 +40,14 @@ func (c *Flags) Setup() {
 	flag.Int64Var(&c.batchSize, "batch_size", 0, "pipeline batch size")
 	flag.BoolVar(&c.parallel, "parallel", false, "should backfill be run in parallel with indexing")
 	flag.BoolVar(&c.force, "force", false, "remove existing reindexing reports")
+
+	flag.StringVar(&c.table, "table", "", "table to restore from archive")
+	flag.StringVar(&c.from, "from", "", "first day to restore (ie. 2020-01-31)")
+	flag.StringVar(&c.to, "to", "", "last day to restore (ie. 2020-01-31)")
+
+	flag.StringVar(&c.name, "name", "", "name of api key")
+	flag.StringVar(&c.scopes, "scopes", "read", "comma separated scopes of api key (read, write, broadcast)")
+	flag.Int64Var(&c.rateLimit, "rate_limit", 0, "requests per minute of api key [Default: API_KEY_RATE_LIMIT]")
 }
 
 // Run executes the command line interface
@@ -73,6 +90,8 @@ func startCommand(cfg *config.Config, flags Flags) error {
 		return startMigrations(cfg)
 	case "server":
 		return startServer(cfg)
+	case "grpc-server":
+		return startGrpcServer(cfg)
 	case "worker":
 		return startWorker(cfg)
 	default:
@@ -125,6 +144,14 @@ func initStore(cfg *config.Config) (*store.Store, error) {
 	return db, nil
 }
 
+// initCache returns cache of HTTP responses or nil when caching is disabled
+func initCache(cfg *config.Config) (cache.Cache, error) {
+	if !cfg.CacheEnabled {
+		return nil, nil
+	}
+	return cache.New(cfg)
+}
+
 func initErrorReporting(cfg *config.Config) {
 	reporting.Init(cfg)
 }
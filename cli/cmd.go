@@ -38,8 +38,16 @@ func runCmd(cfg *config.Config, flags Flags) error {
 		cmdHandlers.IndexerSummarize.Handle(ctx)
 	case "indexer:purge":
 		cmdHandlers.IndexerPurge.Handle(ctx)
+	case "indexer:restore":
+		cmdHandlers.IndexerRestore.Handle(ctx, flags.table, flags.from, flags.to)
+	case "indexer:backfill_share_price":
+		cmdHandlers.IndexerBackfillSharePrice.Handle(ctx)
 	case "validators:decorate":
 		cmdHandlers.DecorateValidators.Handle(ctx, flags.filePath)
+	case "api_keys:create":
+		cmdHandlers.CreateAPIKey.Handle(ctx, flags.name, flags.scopes, flags.rateLimit)
+	case "api_keys:revoke":
+		cmdHandlers.RevokeAPIKey.Handle(ctx, flags.name)
 	default:
 		return errors.New(fmt.Sprintf("command %s not found", flags.runCommand))
 	}
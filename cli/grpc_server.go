@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/server"
+	"github.com/figment-networks/oasishub-indexer/usecase"
+)
+
+func startGrpcServer(cfg *config.Config) error {
+	client, err := initClient(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	grpcHandlers := usecase.NewGrpcHandlers(cfg, db, client)
+
+	a := server.NewGrpc(cfg, grpcHandlers)
+	if err := a.Start(cfg.GrpcListenAddr()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/server"
 	"github.com/figment-networks/oasishub-indexer/usecase"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
 )
 
 func startServer(cfg *config.Config) error {
@@ -18,9 +19,28 @@ func startServer(cfg *config.Config) error {
 	}
 	defer db.Close()
 
+	// Serve gRPC alongside HTTP API when enabled
+	if cfg.GrpcEnabled {
+		grpcHandlers := usecase.NewGrpcHandlers(cfg, db, client)
+		g := server.NewGrpc(cfg, grpcHandlers)
+		go func() {
+			if err := g.Start(cfg.GrpcListenAddr()); err != nil {
+				logger.Error(err)
+			}
+		}()
+	}
+
+	responseCache, err := initCache(cfg)
+	if err != nil {
+		return err
+	}
+	if responseCache != nil {
+		defer responseCache.Close()
+	}
+
 	httpHandlers := usecase.NewHttpHandlers(cfg, db, client)
 
-	a := server.New(cfg, httpHandlers)
+	a := server.New(cfg, httpHandlers, responseCache)
 	if err := a.Start(cfg.ListenAddr()); err != nil {
 		return err
 	}
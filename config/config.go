@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"strings"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -19,6 +21,7 @@ var (
 	errDatabaseRequired            = errors.New("database credentials are required")
 	errIndexWorkerIntervalRequired = errors.New("index worker interval is required")
 	errSyncIntervalInvalid         = errors.New("index worker is invalid")
+	errCacheTTLInvalid             = errors.New("cache ttl is invalid")
 )
 
 // Config holds the configuration data
@@ -27,10 +30,14 @@ type Config struct {
 	ProxyUrl                     string `json:"proxy_url" envconfig:"PROXY_URL"`
 	ServerAddr                   string `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
 	ServerPort                   int64  `json:"server_port" envconfig:"SERVER_PORT" default:"8081"`
+	GrpcAddr                     string `json:"grpc_addr" envconfig:"GRPC_ADDR" default:"127.0.0.1"`
+	GrpcPort                     int64  `json:"grpc_port" envconfig:"GRPC_PORT" default:"8082"`
+	GrpcEnabled                  bool   `json:"grpc_enabled" envconfig:"GRPC_ENABLED"`
 	FirstBlockHeight             int64  `json:"first_block_height" envconfig:"FIRST_BLOCK_HEIGHT" default:"1"`
 	IndexWorkerInterval          string `json:"index_worker_interval" envconfig:"INDEX_WORKER_INTERVAL" default:"@every 15m"`
 	SummarizeWorkerInterval      string `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
 	PurgeWorkerInterval          string `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
+	DispatchWorkerInterval       string `json:"dispatch_worker_interval" envconfig:"DISPATCH_WORKER_INTERVAL" default:"@every 1m"`
 	DefaultBatchSize             int64  `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
 	DatabaseDSN                  string `json:"database_dsn" envconfig:"DATABASE_DSN"`
 	Debug                        bool   `json:"debug" envconfig:"DEBUG"`
@@ -45,7 +52,28 @@ type Config struct {
 	PurgeBalanceEventsInterval   string `json:"purge_balance_events_interval" envconfig:"PURGE_BALANCE_EVENTS_INTERVAL" default:"24h"`
 	PurgeSystemEventsInterval    string `json:"purge_system_events_interval" envconfig:"PURGE_SYSTEM_EVENTS_INTERVAL" default:"24h"`
 	PurgeHourlySummariesInterval string `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"24h"`
+	RetentionPoliciesFile        string `json:"retention_policies_file" envconfig:"RETENTION_POLICIES_FILE"`
+	PurgeArchiveDir              string `json:"purge_archive_dir" envconfig:"PURGE_ARCHIVE_DIR"`
+	SystemEventRulesFile         string `json:"system_event_rules_file" envconfig:"SYSTEM_EVENT_RULES_FILE"`
+	WebhookMaxAttempts           int64  `json:"webhook_max_attempts" envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookTimeout               string `json:"webhook_timeout" envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookAllowPrivateURLs      bool   `json:"webhook_allow_private_urls" envconfig:"WEBHOOK_ALLOW_PRIVATE_URLS" default:"false"`
 	IndexerConfigFile            string `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
+	CacheEnabled                 bool   `json:"cache_enabled" envconfig:"CACHE_ENABLED" default:"true"`
+	CacheMaxBytes                int64  `json:"cache_max_bytes" envconfig:"CACHE_MAX_BYTES" default:"67108864"`
+	CacheRedisUrl                string `json:"cache_redis_url" envconfig:"CACHE_REDIS_URL"`
+	CacheLatestTTL               string `json:"cache_latest_ttl" envconfig:"CACHE_LATEST_TTL" default:"5s"`
+	CacheFinalizedTTL            string `json:"cache_finalized_ttl" envconfig:"CACHE_FINALIZED_TTL" default:"24h"`
+	ApiAnonymousAccess           bool   `json:"api_anonymous_access" envconfig:"API_ANONYMOUS_ACCESS" default:"true"`
+	ApiAnonymousScopes           string `json:"api_anonymous_scopes" envconfig:"API_ANONYMOUS_SCOPES" default:"read"`
+	ApiAnonymousRateLimit        int64  `json:"api_anonymous_rate_limit" envconfig:"API_ANONYMOUS_RATE_LIMIT" default:"60"`
+	ApiKeyRateLimit              int64  `json:"api_key_rate_limit" envconfig:"API_KEY_RATE_LIMIT" default:"600"`
+	ApiForwardedByClientIP       bool   `json:"api_forwarded_by_client_ip" envconfig:"API_FORWARDED_BY_CLIENT_IP"`
+	EpochInterval                int64  `json:"epoch_interval" envconfig:"EPOCH_INTERVAL"`
+	EpochBase                    uint64 `json:"epoch_base" envconfig:"EPOCH_BASE"`
+
+	RetentionPolicies []RetentionPolicy `json:"retention_policies" ignored:"true"`
+	SystemEventRules  []SystemEventRule `json:"system_event_rules" ignored:"true"`
 }
 
 // Validate returns an error if config is invalid
@@ -62,6 +90,12 @@ func (c *Config) Validate() error {
 		return errIndexWorkerIntervalRequired
 	}
 
+	if c.CacheEnabled {
+		if _, _, err := c.CacheTTLs(); err != nil {
+			return errCacheTTLInvalid
+		}
+	}
+
 	return nil
 }
 
@@ -80,6 +114,31 @@ func (c *Config) ListenAddr() string {
 	return fmt.Sprintf("%s:%d", c.ServerAddr, c.ServerPort)
 }
 
+// GrpcListenAddr returns a full listen address and port of gRPC server
+func (c *Config) GrpcListenAddr() string {
+	return fmt.Sprintf("%s:%d", c.GrpcAddr, c.GrpcPort)
+}
+
+// CacheTTLs returns how long responses for the most recent and finalized heights are cached
+func (c *Config) CacheTTLs() (latest time.Duration, finalized time.Duration, err error) {
+	if latest, err = time.ParseDuration(c.CacheLatestTTL); err != nil {
+		return
+	}
+	finalized, err = time.ParseDuration(c.CacheFinalizedTTL)
+	return
+}
+
+// AnonymousScopes returns scopes of requests without api key
+func (c *Config) AnonymousScopes() []string {
+	var scopes []string
+	for _, scope := range strings.Split(c.ApiAnonymousScopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
 // New returns a new config
 func New() *Config {
 	return &Config{}
@@ -40,3 +40,96 @@ func TestValidate(t *testing.T) {
 	config.IndexWorkerInterval = ""
 	assert.Equal(t, config.Validate(), errIndexWorkerIntervalRequired)
 }
+
+func TestGetRetentionPolicies(t *testing.T) {
+	config := Config{
+		PurgeSequencesInterval:       "24h",
+		PurgeHourlySummariesInterval: "48h",
+		PurgeSystemEventsInterval:    "0",
+		PurgeBalanceEventsInterval:   "72h",
+	}
+
+	policies, err := config.GetRetentionPolicies()
+	assert.NoError(t, err)
+	assert.Len(t, policies, 6)
+	assert.Equal(t, RetentionPolicy{Table: "block_summary", TimeInterval: "hour", Interval: "48h", MinSummaryCoverage: "day"}, policies[1])
+
+	config.RetentionPolicies = []RetentionPolicy{{Table: "block_sequences", Interval: "168h", MinSummaryCoverage: "week"}}
+	policies, err = config.GetRetentionPolicies()
+	assert.NoError(t, err)
+	assert.Equal(t, config.RetentionPolicies, policies)
+
+	config.RetentionPolicies = []RetentionPolicy{{Table: "block_sequences"}}
+	_, err = config.GetRetentionPolicies()
+	assert.Equal(t, errRetentionIntervalRequired, err)
+
+	config.RetentionPolicies = []RetentionPolicy{{Table: "block_sequences", Interval: "24h", MinSummaryCoverage: "year"}}
+	_, err = config.GetRetentionPolicies()
+	assert.Error(t, err)
+}
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		description string
+		policy      RetentionPolicy
+		wantErr     bool
+	}{
+		{"accepts sequence table with coverage", RetentionPolicy{Table: "validator_sequences", Interval: "24h", MinSummaryCoverage: "day"}, false},
+		{"accepts summary table with time interval", RetentionPolicy{Table: "balance_summary", TimeInterval: "hour", Interval: "48h", MinSummaryCoverage: "day"}, false},
+		{"accepts table without summaries", RetentionPolicy{Table: "system_events", Interval: "24h"}, false},
+		{"rejects missing table", RetentionPolicy{Interval: "24h"}, true},
+		{"rejects unknown table", RetentionPolicy{Table: "syncables", Interval: "24h"}, true},
+		{"rejects summary table without time interval", RetentionPolicy{Table: "block_summary", Interval: "24h"}, true},
+		{"rejects time interval of sequence table", RetentionPolicy{Table: "block_sequences", TimeInterval: "hour", Interval: "24h"}, true},
+		{"rejects invalid time interval", RetentionPolicy{Table: "block_summary", TimeInterval: "minute", Interval: "24h"}, true},
+		{"rejects coverage of table without summaries", RetentionPolicy{Table: "system_events", Interval: "24h", MinSummaryCoverage: "day"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetSystemEventRules(t *testing.T) {
+	config := Config{}
+
+	rules, err := config.GetSystemEventRules()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultSystemEventRules(), rules)
+
+	config.SystemEventRules = []SystemEventRule{{Kind: "missed_n_of_m", Metric: SystemEventMetricMissedTotal, Comparison: ComparisonGte, Thresholds: []float64{10}}}
+	_, err = config.GetSystemEventRules()
+	assert.Error(t, err, "window is required for missed blocks metrics")
+
+	config.SystemEventRules[0].Window = 100
+	rules, err = config.GetSystemEventRules()
+	assert.NoError(t, err)
+	assert.Equal(t, config.SystemEventRules, rules)
+
+	config.SystemEventRules[0].Comparison = ComparisonBetween
+	_, err = config.GetSystemEventRules()
+	assert.Error(t, err, "between requires two thresholds")
+}
+
+func TestSystemEventRule_Matches(t *testing.T) {
+	rule := SystemEventRule{Comparison: ComparisonBetween, Thresholds: []float64{1, 10}}
+	assert.False(t, rule.Matches(0.9))
+	assert.True(t, rule.Matches(1))
+	assert.True(t, rule.Matches(9.9))
+	assert.False(t, rule.Matches(10))
+
+	rule = SystemEventRule{Comparison: ComparisonGte, Thresholds: []float64{10}}
+	assert.False(t, rule.Matches(9.9))
+	assert.True(t, rule.Matches(10))
+
+	rule = SystemEventRule{Comparison: ComparisonEq, Thresholds: []float64{50}}
+	assert.False(t, rule.Matches(49))
+	assert.True(t, rule.Matches(50))
+}
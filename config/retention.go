@@ -0,0 +1,117 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+var (
+	errRetentionTableRequired    = errors.New("retention policy table is required")
+	errRetentionIntervalRequired = errors.New("retention policy interval is required")
+)
+
+// retentionTable describes table which can be purged
+type retentionTable struct {
+	// isSummary is true when table holds summaries of multiple time intervals
+	isSummary bool
+	// hasSummary is true when table records are summarized, so summary coverage can be checked
+	hasSummary bool
+}
+
+var retentionTables = map[string]retentionTable{
+	"block_sequences":     {hasSummary: true},
+	"validator_sequences": {hasSummary: true},
+	"balance_events":      {hasSummary: true},
+	"system_events":       {},
+	"block_summary":       {isSummary: true, hasSummary: true},
+	"validator_summary":   {isSummary: true, hasSummary: true},
+	"balance_summary":     {isSummary: true, hasSummary: true},
+}
+
+// RetentionPolicy defines how long records of a table are kept
+type RetentionPolicy struct {
+	// Table is the name of the purged table
+	Table string `json:"table"`
+	// TimeInterval restricts purging of summary tables to summaries of given interval
+	TimeInterval types.SummaryInterval `json:"time_interval,omitempty"`
+	// Interval is how long records are kept, counting back from the most recent record. "0" disables purging
+	Interval string `json:"interval"`
+	// MinSummaryCoverage is the summary interval which has to exist for record's time bucket before record can be purged
+	MinSummaryCoverage types.SummaryInterval `json:"min_summary_coverage,omitempty"`
+}
+
+// Validate returns an error if retention policy is invalid
+func (p RetentionPolicy) Validate() error {
+	if p.Table == "" {
+		return errRetentionTableRequired
+	}
+	if p.Interval == "" {
+		return errRetentionIntervalRequired
+	}
+
+	table, ok := retentionTables[p.Table]
+	if !ok {
+		return fmt.Errorf("unknown retention policy table %s", p.Table)
+	}
+
+	if table.isSummary && p.TimeInterval == "" {
+		return fmt.Errorf("time interval is required for summary table %s", p.Table)
+	}
+	if !table.isSummary && p.TimeInterval != "" {
+		return fmt.Errorf("time interval is not supported for table %s", p.Table)
+	}
+	if p.TimeInterval != "" && !p.TimeInterval.Valid() {
+		return fmt.Errorf("invalid time interval %q for table %s", p.TimeInterval, p.Table)
+	}
+
+	if p.MinSummaryCoverage != "" && !table.hasSummary {
+		return fmt.Errorf("min summary coverage is not supported for table %s", p.Table)
+	}
+	if p.MinSummaryCoverage != "" && !p.MinSummaryCoverage.Valid() {
+		return fmt.Errorf("invalid min summary coverage %q for table %s", p.MinSummaryCoverage, p.Table)
+	}
+	return nil
+}
+
+// GetRetentionPolicies returns retention policies used by purge.
+// Policies file takes precedence over policies defined in config.
+// When none are defined, policies are built from purge intervals
+func (c *Config) GetRetentionPolicies() ([]RetentionPolicy, error) {
+	policies := c.RetentionPolicies
+
+	if c.RetentionPoliciesFile != "" {
+		data, err := ioutil.ReadFile(c.RetentionPoliciesFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &policies); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(policies) == 0 {
+		policies = c.defaultRetentionPolicies()
+	}
+
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return policies, nil
+}
+
+func (c *Config) defaultRetentionPolicies() []RetentionPolicy {
+	return []RetentionPolicy{
+		{Table: "block_sequences", Interval: c.PurgeSequencesInterval, MinSummaryCoverage: types.IntervalDaily},
+		{Table: "block_summary", TimeInterval: types.IntervalHourly, Interval: c.PurgeHourlySummariesInterval, MinSummaryCoverage: types.IntervalDaily},
+		{Table: "validator_sequences", Interval: c.PurgeSequencesInterval, MinSummaryCoverage: types.IntervalDaily},
+		{Table: "validator_summary", TimeInterval: types.IntervalHourly, Interval: c.PurgeHourlySummariesInterval, MinSummaryCoverage: types.IntervalDaily},
+		{Table: "system_events", Interval: c.PurgeSystemEventsInterval},
+		{Table: "balance_events", Interval: c.PurgeBalanceEventsInterval, MinSummaryCoverage: types.IntervalDaily},
+	}
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+const (
+	SystemEventMetricActiveEscrowBalanceChange = "active_escrow_balance_change"
+	SystemEventMetricCommissionChange          = "commission_change"
+	SystemEventMetricMissedTotal               = "missed_total"
+	SystemEventMetricMissedInRow               = "missed_in_row"
+	SystemEventMetricVotingPowerRank           = "voting_power_rank"
+	SystemEventMetricValidatorSetSizeChange    = "validator_set_size_change"
+	SystemEventMetricTotalActiveEscrowChange   = "total_active_escrow_change"
+	SystemEventMetricAvgBlockTime              = "avg_block_time"
+	SystemEventMetricCommonPoolChange          = "common_pool_change"
+
+	ComparisonEq      = "eq"
+	ComparisonGt      = "gt"
+	ComparisonGte     = "gte"
+	ComparisonLt      = "lt"
+	ComparisonLte     = "lte"
+	ComparisonBetween = "between"
+)
+
+var (
+	errSystemEventRuleKindRequired = errors.New("system event rule kind is required")
+)
+
+// SystemEventRule defines when system event of given kind is created
+type SystemEventRule struct {
+	// Kind is the kind of created system event
+	Kind string `json:"kind"`
+	// Metric is the name of the value rule is evaluated against
+	Metric string `json:"metric"`
+	// Comparison is the operator used to compare metric value with thresholds
+	Comparison string `json:"comparison"`
+	// Thresholds holds single threshold or, for "between", lower (inclusive) and upper (exclusive) bounds
+	Thresholds []float64 `json:"thresholds"`
+	// Window is the number of preceding validator sequences taken into account by missed_total metric,
+	// or the number of preceding heights for total_active_escrow_change and avg_block_time metrics
+	Window int64 `json:"window,omitempty"`
+}
+
+// Validate returns an error if system event rule is invalid
+func (r SystemEventRule) Validate() error {
+	if r.Kind == "" {
+		return errSystemEventRuleKindRequired
+	}
+
+	switch r.Metric {
+	case SystemEventMetricActiveEscrowBalanceChange, SystemEventMetricCommissionChange, SystemEventMetricVotingPowerRank, SystemEventMetricMissedInRow,
+		SystemEventMetricValidatorSetSizeChange, SystemEventMetricCommonPoolChange:
+	case SystemEventMetricMissedTotal, SystemEventMetricTotalActiveEscrowChange, SystemEventMetricAvgBlockTime:
+		if r.Window <= 0 {
+			return fmt.Errorf("window is required for metric %s of rule %s", r.Metric, r.Kind)
+		}
+	default:
+		return fmt.Errorf("invalid metric %q for rule %s", r.Metric, r.Kind)
+	}
+
+	thresholds := 1
+	switch r.Comparison {
+	case ComparisonEq, ComparisonGt, ComparisonGte, ComparisonLt, ComparisonLte:
+	case ComparisonBetween:
+		thresholds = 2
+	default:
+		return fmt.Errorf("invalid comparison %q for rule %s", r.Comparison, r.Kind)
+	}
+
+	if len(r.Thresholds) != thresholds {
+		return fmt.Errorf("rule %s requires %d threshold(s)", r.Kind, thresholds)
+	}
+	return nil
+}
+
+// Matches returns true if value satisfies the rule
+func (r SystemEventRule) Matches(value float64) bool {
+	switch r.Comparison {
+	case ComparisonEq:
+		return value == r.Thresholds[0]
+	case ComparisonGt:
+		return value > r.Thresholds[0]
+	case ComparisonGte:
+		return value >= r.Thresholds[0]
+	case ComparisonLt:
+		return value < r.Thresholds[0]
+	case ComparisonLte:
+		return value <= r.Thresholds[0]
+	case ComparisonBetween:
+		return value >= r.Thresholds[0] && value < r.Thresholds[1]
+	default:
+		return false
+	}
+}
+
+// GetSystemEventRules returns rules used to create system events.
+// Rules file takes precedence over rules defined in config.
+// When none are defined, default rules are used
+func (c *Config) GetSystemEventRules() ([]SystemEventRule, error) {
+	rules := c.SystemEventRules
+
+	if c.SystemEventRulesFile != "" {
+		data, err := ioutil.ReadFile(c.SystemEventRulesFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(rules) == 0 {
+		rules = DefaultSystemEventRules()
+	}
+
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+// DefaultSystemEventRules returns rules used when none are configured
+func DefaultSystemEventRules() []SystemEventRule {
+	return []SystemEventRule{
+		{Kind: "active_escrow_balance_change_1", Metric: SystemEventMetricActiveEscrowBalanceChange, Comparison: ComparisonBetween, Thresholds: []float64{0.1, 1}},
+		{Kind: "active_escrow_balance_change_2", Metric: SystemEventMetricActiveEscrowBalanceChange, Comparison: ComparisonBetween, Thresholds: []float64{1, 10}},
+		{Kind: "active_escrow_balance_change_3", Metric: SystemEventMetricActiveEscrowBalanceChange, Comparison: ComparisonGte, Thresholds: []float64{10}},
+		{Kind: "commission_change_1", Metric: SystemEventMetricCommissionChange, Comparison: ComparisonBetween, Thresholds: []float64{0.1, 1}},
+		{Kind: "commission_change_2", Metric: SystemEventMetricCommissionChange, Comparison: ComparisonBetween, Thresholds: []float64{1, 10}},
+		{Kind: "commission_change_3", Metric: SystemEventMetricCommissionChange, Comparison: ComparisonGte, Thresholds: []float64{10}},
+		{Kind: "missed_n_of_m", Metric: SystemEventMetricMissedTotal, Comparison: ComparisonEq, Thresholds: []float64{50}, Window: 1000},
+		{Kind: "missed_n_consecutive", Metric: SystemEventMetricMissedInRow, Comparison: ComparisonEq, Thresholds: []float64{50}},
+		{Kind: "entered_top_10", Metric: SystemEventMetricVotingPowerRank, Comparison: ComparisonLte, Thresholds: []float64{10}},
+		{Kind: "left_top_10", Metric: SystemEventMetricVotingPowerRank, Comparison: ComparisonGt, Thresholds: []float64{10}},
+		{Kind: "validator_set_size_changed", Metric: SystemEventMetricValidatorSetSizeChange, Comparison: ComparisonGte, Thresholds: []float64{1}},
+		{Kind: "total_active_escrow_changed", Metric: SystemEventMetricTotalActiveEscrowChange, Comparison: ComparisonGte, Thresholds: []float64{5}, Window: 600},
+		{Kind: "block_time_degraded", Metric: SystemEventMetricAvgBlockTime, Comparison: ComparisonGte, Thresholds: []float64{10}, Window: 100},
+		{Kind: "common_pool_changed", Metric: SystemEventMetricCommonPoolChange, Comparison: ComparisonGte, Thresholds: []float64{1}},
+	}
+}
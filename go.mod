@@ -5,16 +5,21 @@ go 1.14
 require (
 	github.com/figment-networks/indexing-engine v0.1.11
 	github.com/figment-networks/oasis-rpc-proxy v0.6.0
+	github.com/gin-contrib/sse v0.1.0
 	github.com/gin-gonic/gin v1.5.0
+	github.com/go-redis/redis/v8 v8.4.4
 	github.com/golang-migrate/migrate/v4 v4.11.0
 	github.com/golang/mock v1.4.3
 	github.com/golang/protobuf v1.4.2
+	github.com/graph-gophers/graphql-go v1.3.0
 	github.com/jinzhu/gorm v1.9.12
 	github.com/kelseyhightower/envconfig v1.4.0
+	github.com/lib/pq v1.3.0
 	github.com/pkg/errors v0.9.1
 	github.com/robfig/cron/v3 v3.0.1
 	github.com/rollbar/rollbar-go v1.2.0
 	github.com/stretchr/testify v1.6.1
 	go.uber.org/zap v1.15.0
 	google.golang.org/grpc v1.32.0
+	google.golang.org/protobuf v1.25.0
 )
@@ -152,6 +152,7 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 
 	var newValidatorAggs []model.ValidatorAgg
 	var updatedValidatorAggs []model.ValidatorAgg
+	var previousValidatorAggs []model.ValidatorAgg
 	for _, rawValidator := range payload.RawValidators {
 		existing, err := t.db.FindByEntityUID(rawValidator.GetNode().GetEntityId())
 		address := rawValidator.GetAddress()
@@ -169,6 +170,7 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 					Address:                 rawValidator.GetAddress(),
 					EntityUID:               rawValidator.GetNode().GetEntityId(),
 					RecentTendermintAddress: rawValidator.GetTendermintAddress(),
+					RecentNodeID:            rawValidator.GetNode().GetId(),
 					RecentVotingPower:       rawValidator.GetVotingPower(),
 					RecentCommission:        types.NewQuantityFromBytes(rawValidator.GetCommission()),
 					RecentAsValidatorHeight: payload.Syncable.Height,
@@ -212,6 +214,7 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 					RecentAt:       payload.Syncable.Time,
 				},
 				RecentTendermintAddress: rawValidator.GetTendermintAddress(),
+				RecentNodeID:            rawValidator.GetNode().GetId(),
 				RecentVotingPower:       rawValidator.GetVotingPower(),
 				RecentCommission:        types.NewQuantityFromBytes(rawValidator.GetCommission()),
 				RecentAsValidatorHeight: payload.Syncable.Height,
@@ -246,6 +249,12 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 				}
 			}
 
+			// Keep stored state, so analyzer can compare it with incoming data
+			previous := *existing
+			previousAggregate := *existing.Aggregate
+			previous.Aggregate = &previousAggregate
+			previousValidatorAggs = append(previousValidatorAggs, previous)
+
 			existing.Update(validator)
 
 			updatedValidatorAggs = append(updatedValidatorAggs, *existing)
@@ -253,5 +262,6 @@ func (t *validatorAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) e
 	}
 	payload.NewAggregatedValidators = newValidatorAggs
 	payload.UpdatedAggregatedValidators = updatedValidatorAggs
+	payload.PreviousAggregatedValidators = previousValidatorAggs
 	return nil
 }
@@ -413,6 +413,16 @@ func TestValidatorAggCreatorTask_Run(t *testing.T) {
 				return
 			}
 
+			if len(payload.PreviousAggregatedValidators) != len(tt.raw) {
+				t.Errorf("expected payload.PreviousAggregatedValidators to contain accounts, got: %v; want: %v", len(payload.PreviousAggregatedValidators), len(tt.raw))
+				return
+			}
+			for _, val := range payload.PreviousAggregatedValidators {
+				if val.RecentNodeID != "" || val.RecentAtHeight != 0 {
+					t.Errorf("expected payload.PreviousAggregatedValidators to contain stored state, got: %v", val)
+				}
+			}
+
 			for _, expectVal := range expectExisting {
 				var found bool
 				for _, val := range payload.UpdatedAggregatedValidators {
@@ -518,6 +528,7 @@ func updateValidatorAgg(original *model.ValidatorAgg, raw *validatorpb.Validator
 		EntityUID:               original.EntityUID,
 		Address:                 original.Address,
 		RecentTendermintAddress: raw.GetTendermintAddress(),
+		RecentNodeID:            raw.GetNode().GetId(),
 		RecentVotingPower:       raw.GetVotingPower(),
 		RecentAsValidatorHeight: pl.Syncable.Height,
 	}
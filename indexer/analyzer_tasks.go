@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+	"sort"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/figment-networks/indexing-engine/pipeline"
@@ -13,26 +16,30 @@ import (
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
-	"github.com/pkg/errors"
 )
 
 const (
-	TaskNameSystemEventCreator = "SystemEventCreator"
+	TaskNameSystemEventCreator          = "SystemEventCreator"
+	TaskNameNetworkSystemEventCreator   = "NetworkSystemEventCreator"
+	TaskNameDelegatorSystemEventCreator = "DelegatorSystemEventCreator"
 )
 
-var (
-	ErrActiveEscrowBalanceOutsideOfRange = errors.New("active escrow balance is outside of specified buckets")
-	ErrCommissionOutsideOfRange          = errors.New("commission is outside of specified buckets")
-
-	MaxValidatorSequences int64 = 1000
-	MissedForMaxThreshold int64 = 50
-	MissedInRowThreshold  int64 = 50
-)
+// valueChangeMetrics return values before and after change for metrics comparing validator sequences at consecutive heights
+var valueChangeMetrics = map[string]func(model.ValidatorSeq) *big.Int{
+	config.SystemEventMetricActiveEscrowBalanceChange: func(seq model.ValidatorSeq) *big.Int {
+		return &seq.ActiveEscrowBalance.Int
+	},
+	config.SystemEventMetricCommissionChange: func(seq model.ValidatorSeq) *big.Int {
+		return &seq.Commission.Int
+	},
+}
 
 // NewSystemEventCreatorTask creates system events
-func NewSystemEventCreatorTask(cfg *config.Config, s SystemEventCreatorStore) *systemEventCreatorTask {
+func NewSystemEventCreatorTask(cfg *config.Config, rules []config.SystemEventRule, s SystemEventCreatorStore, u SystemEventCreatorUptimeStore) *systemEventCreatorTask {
 	return &systemEventCreatorTask{
 		cfg:                     cfg,
+		rules:                   rules,
+		uptimeStore:             u,
 		metricObserver:          indexerTaskDuration.WithLabels(TaskNameSystemEventCreator),
 		SystemEventCreatorStore: s,
 	}
@@ -40,11 +47,17 @@ func NewSystemEventCreatorTask(cfg *config.Config, s SystemEventCreatorStore) *s
 
 type SystemEventCreatorStore interface {
 	FindByHeight(int64) ([]model.ValidatorSeq, error)
-	FindLastByAddress(string, int64) ([]model.ValidatorSeq, error)
+	FindLastByAddress(string, store.Pagination) ([]model.ValidatorSeq, error)
+}
+
+type SystemEventCreatorUptimeStore interface {
+	FindByAddresses([]string) ([]model.ValidatorUptime, error)
 }
 
 type systemEventCreatorTask struct {
-	cfg *config.Config
+	cfg         *config.Config
+	rules       []config.SystemEventRule
+	uptimeStore SystemEventCreatorUptimeStore
 
 	metricObserver metrics.Observer
 	SystemEventCreatorStore
@@ -82,11 +95,30 @@ func (t *systemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) er
 	}
 	payload.SystemEvents = append(payload.SystemEvents, activeSetPresenceChangeSystemEvents...)
 
-	missedBlocksSystemEvents, err := t.getMissedBlocksSystemEvents(currHeightValidatorSequences)
+	missedBlocksSystemEvents, validatorUptimes, err := t.getMissedBlocksSystemEvents(currHeightValidatorSequences)
 	if err != nil {
 		return err
 	}
 	payload.SystemEvents = append(payload.SystemEvents, missedBlocksSystemEvents...)
+	payload.ValidatorUptimes = validatorUptimes
+
+	slashedSystemEvents, err := t.getSlashedSystemEvents(payload, currHeightValidatorSequences)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, slashedSystemEvents...)
+
+	lifecycleSystemEvents, err := t.getLifecycleSystemEvents(payload, currHeightValidatorSequences)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, lifecycleSystemEvents...)
+
+	votingPowerRankSystemEvents, err := t.getVotingPowerRankSystemEvents(currHeightValidatorSequences, prevHeightValidatorSequences)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, votingPowerRankSystemEvents...)
 
 	return nil
 }
@@ -105,91 +137,171 @@ func (t *systemEventCreatorTask) getPrevHeightValidatorSequences(payload *payloa
 	return prevHeightValidatorSequences, nil
 }
 
-func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
+// getMissedBlocksSystemEvents records precommit results of validators in their uptime windows
+// and returns system events for rules matching missed blocks metrics
+func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, []model.ValidatorUptime, error) {
+	size := t.getUptimeWindowSize()
+	if size == 0 {
+		return nil, nil, nil
+	}
+
+	uptimes, err := t.getValidatorUptimes(currHeightValidatorSequences)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var systemEvents []*model.SystemEvent
+	var updatedUptimes []model.ValidatorUptime
 	for _, validatorSequence := range currHeightValidatorSequences {
-		// When current height validator has validated the block no need to check last records
-		if t.isValidated(validatorSequence) {
-			return systemEvents, nil
+		uptime, ok := uptimes[validatorSequence.Address]
+		if !ok {
+			uptime, err = t.newValidatorUptime(validatorSequence, size)
+			if err != nil {
+				return nil, nil, err
+			}
 		}
 
-		lastValidatorSequencesForAddress, err := t.SystemEventCreatorStore.FindLastByAddress(validatorSequence.Address, MaxValidatorSequences)
-		if err != nil {
-			if err == store.ErrNotFound {
-				return systemEvents, nil
-			} else {
-				return nil, err
+		// Height was already recorded, ie. when it is reindexed
+		if uptime.Height >= validatorSequence.Height {
+			continue
+		}
+
+		uptime.Resize(size)
+		prevMissedInRow := uptime.MissedInRow
+		missed := t.isNotValidated(validatorSequence)
+		uptime.Record(validatorSequence.Height, missed)
+		updatedUptimes = append(updatedUptimes, *uptime)
+
+		if !missed {
+			recoveredSystemEvent, err := t.getRecovered(validatorSequence, prevMissedInRow)
+			if err != nil {
+				return nil, nil, err
 			}
-		} else {
-			var validatorSequencesToCheck []model.ValidatorSeq
-			validatorSequencesToCheck = append([]model.ValidatorSeq{validatorSequence}, lastValidatorSequencesForAddress...)
-			totalMissedCount := t.getTotalMissed(validatorSequencesToCheck)
+			if recoveredSystemEvent != nil {
+				systemEvents = append(systemEvents, recoveredSystemEvent)
+			}
+			continue
+		}
 
-			logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", MaxValidatorSequences, validatorSequence.Address, totalMissedCount))
+		for _, rule := range t.rules {
+			if !t.isMissedBlocksMetric(rule.Metric) {
+				continue
+			}
 
-			if totalMissedCount == MissedForMaxThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventMissedNofM, systemEventRawData{
-					"threshold":               MissedForMaxThreshold,
-					"max_validator_sequences": MaxValidatorSequences,
-				})
-				if err != nil {
-					return nil, err
+			var value int64
+			var data systemEventRawData
+			switch rule.Metric {
+			case config.SystemEventMetricMissedTotal:
+				value = uptime.MissedTotal(rule.Window + 1)
+				data = systemEventRawData{
+					"threshold":               rule.Thresholds[0],
+					"max_validator_sequences": rule.Window,
 				}
+			case config.SystemEventMetricMissedInRow:
+				value = uptime.MissedInRow
+				data = systemEventRawData{
+					"threshold": rule.Thresholds[0],
+				}
+			}
 
-				systemEvents = append(systemEvents, newSystemEvent)
+			logger.Debug(fmt.Sprintf("%s for address %s: %d [window=%d]", rule.Metric, validatorSequence.Address, value, rule.Window))
+
+			if !rule.Matches(float64(value)) {
+				continue
 			}
 
-			missedInRowCount := t.getMissedInRow(validatorSequencesToCheck, MissedInRowThreshold)
+			newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventKind(rule.Kind), data)
+			if err != nil {
+				return nil, nil, err
+			}
 
-			logger.Debug(fmt.Sprintf("total missed blocks in a row for address %s: %d", validatorSequence.Address, missedInRowCount))
+			systemEvents = append(systemEvents, newSystemEvent)
+		}
+	}
+	return systemEvents, updatedUptimes, nil
+}
 
-			if missedInRowCount == MissedInRowThreshold {
-				newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventMissedNConsecutive, systemEventRawData{
-					"threshold": MissedInRowThreshold,
-				})
-				if err != nil {
-					return nil, err
-				}
+// getRecovered returns recovered system event when validator signed the block after missing
+// enough blocks in a row to match any missed_in_row rule
+func (t *systemEventCreatorTask) getRecovered(validatorSequence model.ValidatorSeq, prevMissedInRow int64) (*model.SystemEvent, error) {
+	if prevMissedInRow == 0 {
+		return nil, nil
+	}
 
-				systemEvents = append(systemEvents, newSystemEvent)
-			}
+	for _, rule := range t.rules {
+		if rule.Metric != config.SystemEventMetricMissedInRow || float64(prevMissedInRow) < rule.Thresholds[0] {
+			continue
 		}
+
+		return t.newSystemEvent(validatorSequence, model.SystemEventRecovered, systemEventRawData{
+			"missed_in_row": prevMissedInRow,
+		})
 	}
-	return systemEvents, nil
+	return nil, nil
 }
 
-// getTotalMissed get total missed count for given slice of validator sequences
-func (t systemEventCreatorTask) getTotalMissed(validatorSequences []model.ValidatorSeq) int64 {
-	var totalMissedCount int64 = 0
-	for _, validatorSequence := range validatorSequences {
-		if t.isNotValidated(validatorSequence) {
-			totalMissedCount += 1
+// getUptimeWindowSize returns number of validator sequences required to evaluate missed blocks rules
+func (t *systemEventCreatorTask) getUptimeWindowSize() int64 {
+	var size int64
+	for _, rule := range t.rules {
+		switch rule.Metric {
+		case config.SystemEventMetricMissedTotal:
+			// Window does not include validator sequence at current height
+			if rule.Window+1 > size {
+				size = rule.Window + 1
+			}
+		case config.SystemEventMetricMissedInRow:
+			if size == 0 {
+				size = 1
+			}
 		}
 	}
+	return size
+}
+
+// getValidatorUptimes returns stored uptimes of validators by address
+func (t *systemEventCreatorTask) getValidatorUptimes(validatorSequences []model.ValidatorSeq) (map[string]*model.ValidatorUptime, error) {
+	addresses := make([]string, len(validatorSequences))
+	for i, validatorSequence := range validatorSequences {
+		addresses[i] = validatorSequence.Address
+	}
+
+	stored, err := t.uptimeStore.FindByAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
 
-	return totalMissedCount
+	uptimes := make(map[string]*model.ValidatorUptime, len(stored))
+	for i := range stored {
+		uptimes[stored[i].Address] = &stored[i]
+	}
+	return uptimes, nil
 }
 
-// getMissedInRow get number of validator sequences missed in the row
-func (t systemEventCreatorTask) getMissedInRow(validatorSequences []model.ValidatorSeq, limit int64) int64 {
-	if int64(len(validatorSequences)) > MissedInRowThreshold {
-		validatorSequences = validatorSequences[:limit]
+// newValidatorUptime returns uptime of validator with no stored uptime.
+// Window is filled with validator sequences indexed before the current one
+func (t *systemEventCreatorTask) newValidatorUptime(currValidatorSeq model.ValidatorSeq, size int64) (*model.ValidatorUptime, error) {
+	uptime := model.NewValidatorUptime(currValidatorSeq.Address, size)
+	if size == 1 {
+		return uptime, nil
 	}
 
-	var missedInRowCount int64 = 0
-	prevValidated := false
-	for _, validatorSequence := range validatorSequences {
-		if t.isNotValidated(validatorSequence) {
-			if !prevValidated {
-				missedInRowCount += 1
-			}
-			prevValidated = false
-		} else {
-			prevValidated = true
+	lastValidatorSequences, err := t.SystemEventCreatorStore.FindLastByAddress(currValidatorSeq.Address, store.Pagination{Limit: size - 1})
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+
+	for i := len(lastValidatorSequences) - 1; i >= 0; i-- {
+		if lastValidatorSequences[i].Height >= currValidatorSeq.Height {
+			continue
 		}
+		uptime.Record(lastValidatorSequences[i].Height, t.isNotValidated(lastValidatorSequences[i]))
 	}
+	return uptime, nil
+}
 
-	return missedInRowCount
+func (t systemEventCreatorTask) isMissedBlocksMetric(metric string) bool {
+	return metric == config.SystemEventMetricMissedTotal || metric == config.SystemEventMetricMissedInRow
 }
 
 // isNotValidated check if validator has validated the block at height
@@ -197,10 +309,6 @@ func (t systemEventCreatorTask) isNotValidated(validatorSequence model.Validator
 	return validatorSequence.PrecommitValidated != nil && !*validatorSequence.PrecommitValidated
 }
 
-func (t systemEventCreatorTask) isValidated(validatorSequence model.ValidatorSeq) bool {
-	return !t.isNotValidated(validatorSequence)
-}
-
 func (t *systemEventCreatorTask) getActiveSetPresenceChangeSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, prevHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
 	var systemEvents []*model.SystemEvent
 
@@ -254,24 +362,21 @@ func (t *systemEventCreatorTask) getValueChangeSystemEvents(currHeightValidatorS
 	for _, validatorSequence := range currHeightValidatorSequences {
 		for _, prevValidatorSequence := range prevHeightValidatorSequences {
 			if validatorSequence.Address == prevValidatorSequence.Address {
-				newSystemEvent, err := t.getActiveEscrowBalanceChange(validatorSequence, prevValidatorSequence)
-				if err != nil {
-					if err != ErrActiveEscrowBalanceOutsideOfRange {
-						return nil, err
+				for _, rule := range t.rules {
+					getValue, ok := valueChangeMetrics[rule.Metric]
+					if !ok {
+						continue
 					}
-				} else {
-					logger.Debug(fmt.Sprintf("active escrow balance change for address %s occured [kind=%s]", validatorSequence.Address, newSystemEvent.Kind))
-					systemEvents = append(systemEvents, newSystemEvent)
-				}
 
-				newSystemEvent, err = t.getCommissionChange(validatorSequence, prevValidatorSequence)
-				if err != nil {
-					if err != ErrCommissionOutsideOfRange {
+					newSystemEvent, err := t.getValueChange(rule, getValue(validatorSequence), getValue(prevValidatorSequence), validatorSequence)
+					if err != nil {
 						return nil, err
 					}
-				} else {
-					logger.Debug(fmt.Sprintf("commission change for address %s occured [kind=%s]", validatorSequence.Address, newSystemEvent.Kind))
-					systemEvents = append(systemEvents, newSystemEvent)
+
+					if newSystemEvent != nil {
+						logger.Debug(fmt.Sprintf("%s for address %s occured [kind=%s]", rule.Metric, validatorSequence.Address, newSystemEvent.Kind))
+						systemEvents = append(systemEvents, newSystemEvent)
+					}
 				}
 			}
 		}
@@ -280,65 +385,227 @@ func (t *systemEventCreatorTask) getValueChangeSystemEvents(currHeightValidatorS
 	return systemEvents, nil
 }
 
-func (t *systemEventCreatorTask) getActiveEscrowBalanceChange(currValidatorSeq model.ValidatorSeq, prevValidatorSeq model.ValidatorSeq) (*model.SystemEvent, error) {
-	currValue := currValidatorSeq.ActiveEscrowBalance.Int64()
-	prevValue := prevValidatorSeq.ActiveEscrowBalance.Int64()
-	roundedChangeRate := t.getRoundedChangeRate(currValue, prevValue)
+// getValueChange returns system event when change rate of value matches the rule
+func (t *systemEventCreatorTask) getValueChange(rule config.SystemEventRule, currValue *big.Int, prevValue *big.Int, currValidatorSeq model.ValidatorSeq) (*model.SystemEvent, error) {
+	roundedChangeRate := getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventActiveEscrowBalanceChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventActiveEscrowBalanceChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventActiveEscrowBalanceChange3
-	} else {
-		return nil, ErrActiveEscrowBalanceOutsideOfRange
-	}
-
-	return t.newSystemEvent(currValidatorSeq, kind, systemEventRawData{
-		"before": prevValue,
-		"after":  currValue,
-		"change": roundedChangeRate,
+	if !rule.Matches(roundedAbsChangeRate) {
+		return nil, nil
+	}
+
+	return t.newSystemEvent(currValidatorSeq, model.SystemEventKind(rule.Kind), systemEventRawData{
+		"before": prevValue.String(),
+		"after":  currValue.String(),
+		"change": getChangeRate(currValue, prevValue).FloatString(1),
 	})
 }
 
-func (t *systemEventCreatorTask) getCommissionChange(currValidatorSeq model.ValidatorSeq, prevValidatorSeq model.ValidatorSeq) (*model.SystemEvent, error) {
-	currValue := currValidatorSeq.Commission.Int64()
-	prevValue := prevValidatorSeq.Commission.Int64()
-	roundedChangeRate := t.getRoundedChangeRate(currValue, prevValue)
-	roundedAbsChangeRate := math.Abs(roundedChangeRate)
+// getChangeRate returns exact change rate in percent computed as (prev - curr) * 100 / prev.
+// Current value is returned when previous value is zero, values can exceed int64
+func getChangeRate(currValue *big.Int, prevValue *big.Int) *big.Rat {
+	if prevValue.Sign() == 0 {
+		return new(big.Rat).SetInt(currValue)
+	}
+
+	diff := new(big.Int).Sub(prevValue, currValue)
+	diff.Mul(diff, big.NewInt(100))
+	return new(big.Rat).SetFrac(diff, prevValue)
+}
+
+// getRoundedChangeRate returns change rate in percent rounded to 0.1
+func getRoundedChangeRate(currValue *big.Int, prevValue *big.Int) float64 {
+	changeRate, _ := getChangeRate(currValue, prevValue).Float64()
+
+	roundedChangeRate := math.Round(changeRate/0.1) * 0.1
+	return roundedChangeRate
+}
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventCommissionChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventCommissionChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventCommissionChange3
-	} else {
-		return nil, ErrCommissionOutsideOfRange
-	}
-
-	return t.newSystemEvent(currValidatorSeq, kind, systemEventRawData{
-		"before": prevValue,
-		"after":  currValue,
-		"change": roundedChangeRate,
+// getSlashedSystemEvents returns single system event for every validator slashed at current height.
+// System events are unique by height, actor and kind, so slashed escrow pools are listed in its data
+func (t *systemEventCreatorTask) getSlashedSystemEvents(payload *payload, currHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
+	var addresses []string
+	slashesByAddress := make(map[string][]ParsedSlash)
+	for _, slash := range payload.ParsedSlashes {
+		if _, ok := slashesByAddress[slash.EscrowAddress]; !ok {
+			addresses = append(addresses, slash.EscrowAddress)
+		}
+		slashesByAddress[slash.EscrowAddress] = append(slashesByAddress[slash.EscrowAddress], slash)
+	}
+
+	var systemEvents []*model.SystemEvent
+	for _, address := range addresses {
+		seq := t.getValidatorSequence(payload, currHeightValidatorSequences, address)
+
+		total := new(big.Int)
+		totalBalance := new(big.Int)
+		var pools []systemEventRawData
+		for _, slash := range slashesByAddress[address] {
+			total.Add(total, &slash.Amount.Int)
+			totalBalance.Add(totalBalance, &slash.Balance.Int)
+			pools = append(pools, systemEventRawData{
+				"pool":     slash.Pool,
+				"amount":   slash.Amount.String(),
+				"balance":  slash.Balance.String(),
+				"fraction": t.getSlashedFraction(&slash.Amount.Int, &slash.Balance.Int),
+			})
+		}
+
+		// Fraction of stake lost is relative to the total balance of slashed escrow pools
+		newSystemEvent, err := t.newSystemEvent(seq, model.SystemEventSlashed, systemEventRawData{
+			"amount":   total.String(),
+			"fraction": t.getSlashedFraction(total, totalBalance),
+			"pools":    pools,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug(fmt.Sprintf("slash for address %s occured [amount=%s]", address, total.String()))
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+	return systemEvents, nil
+}
+
+// getLifecycleSystemEvents compares incoming validator data with stored validator aggregates
+// and returns system events for registered validators and rotated keys
+func (t *systemEventCreatorTask) getLifecycleSystemEvents(payload *payload, currHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
+	var systemEvents []*model.SystemEvent
+	for _, validatorAgg := range payload.NewAggregatedValidators {
+		seq := t.getValidatorSequence(payload, currHeightValidatorSequences, validatorAgg.Address)
+
+		newSystemEvent, err := t.newSystemEvent(seq, model.SystemEventValidatorRegistered, systemEventRawData{
+			"entity_uid":         validatorAgg.EntityUID,
+			"node_id":            validatorAgg.RecentNodeID,
+			"tendermint_address": validatorAgg.RecentTendermintAddress,
+		})
+		if err != nil {
+			return nil, err
+		}
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+
+	for _, prevValidatorAgg := range payload.PreviousAggregatedValidators {
+		for _, validatorAgg := range payload.UpdatedAggregatedValidators {
+			if validatorAgg.EntityUID != prevValidatorAgg.EntityUID {
+				continue
+			}
+
+			seq := t.getValidatorSequence(payload, currHeightValidatorSequences, validatorAgg.Address)
+
+			changes := []struct {
+				kind   model.SystemEventKind
+				before string
+				after  string
+			}{
+				{kind: model.SystemEventTendermintAddressChanged, before: prevValidatorAgg.RecentTendermintAddress, after: validatorAgg.RecentTendermintAddress},
+				{kind: model.SystemEventNodeChanged, before: prevValidatorAgg.RecentNodeID, after: validatorAgg.RecentNodeID},
+			}
+			for _, change := range changes {
+				// Empty value was not indexed yet, so there is nothing to compare with
+				if change.before == "" || change.before == change.after {
+					continue
+				}
+
+				newSystemEvent, err := t.newSystemEvent(seq, change.kind, systemEventRawData{
+					"entity_uid": validatorAgg.EntityUID,
+					"before":     change.before,
+					"after":      change.after,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				logger.Debug(fmt.Sprintf("%s for address %s occured", change.kind, validatorAgg.Address))
+				systemEvents = append(systemEvents, newSystemEvent)
+			}
+		}
+	}
+	return systemEvents, nil
+}
+
+// getVotingPowerRankSystemEvents returns system events for validators which voting power rank started to match the rule
+func (t *systemEventCreatorTask) getVotingPowerRankSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, prevHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
+	currRanks := t.getVotingPowerRanks(currHeightValidatorSequences)
+	prevRanks := t.getVotingPowerRanks(prevHeightValidatorSequences)
+
+	var systemEvents []*model.SystemEvent
+	for _, validatorSequence := range currHeightValidatorSequences {
+		prevRank, ok := prevRanks[validatorSequence.Address]
+		if !ok {
+			continue
+		}
+		currRank := currRanks[validatorSequence.Address]
+
+		for _, rule := range t.rules {
+			if rule.Metric != config.SystemEventMetricVotingPowerRank {
+				continue
+			}
+
+			if !rule.Matches(float64(currRank)) || rule.Matches(float64(prevRank)) {
+				continue
+			}
+
+			newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventKind(rule.Kind), systemEventRawData{
+				"before": prevRank,
+				"after":  currRank,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			logger.Debug(fmt.Sprintf("%s for address %s occured [kind=%s]", rule.Metric, validatorSequence.Address, newSystemEvent.Kind))
+			systemEvents = append(systemEvents, newSystemEvent)
+		}
+	}
+	return systemEvents, nil
+}
+
+// getVotingPowerRanks returns ranks of validators ordered by voting power, starting from 1
+func (t *systemEventCreatorTask) getVotingPowerRanks(validatorSequences []model.ValidatorSeq) map[string]int64 {
+	sorted := make([]model.ValidatorSeq, len(validatorSequences))
+	copy(sorted, validatorSequences)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].VotingPower == sorted[j].VotingPower {
+			return sorted[i].Address < sorted[j].Address
+		}
+		return sorted[i].VotingPower > sorted[j].VotingPower
 	})
+
+	ranks := make(map[string]int64, len(sorted))
+	for i, validatorSequence := range sorted {
+		ranks[validatorSequence.Address] = int64(i + 1)
+	}
+	return ranks
 }
 
-func (t *systemEventCreatorTask) getRoundedChangeRate(currValue int64, prevValue int64) float64 {
-	var changeRate float64
+// getValidatorSequence returns current height sequence of validator.
+// Sequence built from the syncable is returned when validator has no sequence
+func (t *systemEventCreatorTask) getValidatorSequence(payload *payload, currHeightValidatorSequences []model.ValidatorSeq, address string) model.ValidatorSeq {
+	for _, validatorSequence := range currHeightValidatorSequences {
+		if validatorSequence.Address == address {
+			return validatorSequence
+		}
+	}
 
-	if prevValue == 0 {
-		changeRate = float64(currValue)
-	} else {
-		changeRate = (float64(1) - (float64(currValue) / float64(prevValue))) * 100
+	return model.ValidatorSeq{
+		Sequence: &model.Sequence{
+			Height: payload.CurrentHeight,
+			Time:   payload.Syncable.Time,
+		},
+		Address: address,
 	}
+}
 
-	roundedChangeRate := math.Round(changeRate/0.1) * 0.1
-	return roundedChangeRate
+// getSlashedFraction returns fraction of balance before slashing which was taken
+func (t *systemEventCreatorTask) getSlashedFraction(amount *big.Int, balance *big.Int) float64 {
+	before := new(big.Int).Add(balance, amount)
+	if before.Sign() == 0 {
+		return 0
+	}
+
+	fraction, _ := new(big.Rat).SetFrac(amount, before).Float64()
+	return fraction
 }
 
 func (t *systemEventCreatorTask) newSystemEvent(seq model.ValidatorSeq, kind model.SystemEventKind, data map[string]interface{}) (*model.SystemEvent, error) {
@@ -355,3 +622,547 @@ func (t *systemEventCreatorTask) newSystemEvent(seq model.ValidatorSeq, kind mod
 		Data:   types.Jsonb{RawMessage: marshaledData},
 	}, nil
 }
+
+// NewNetworkSystemEventCreatorTask creates system events concerning the whole network
+func NewNetworkSystemEventCreatorTask(cfg *config.Config, rules []config.SystemEventRule, v NetworkSystemEventCreatorValidatorSeqStore, b NetworkSystemEventCreatorBlockSeqStore, s NetworkSystemEventCreatorStakingSeqStore) *networkSystemEventCreatorTask {
+	return &networkSystemEventCreatorTask{
+		cfg:               cfg,
+		rules:             rules,
+		validatorSeqStore: v,
+		blockSeqStore:     b,
+		stakingSeqStore:   s,
+		metricObserver:    indexerTaskDuration.WithLabels(TaskNameNetworkSystemEventCreator),
+	}
+}
+
+type NetworkSystemEventCreatorValidatorSeqStore interface {
+	FindByHeight(int64) ([]model.ValidatorSeq, error)
+}
+
+type NetworkSystemEventCreatorBlockSeqStore interface {
+	GetAvgRecentTimes(int64) (*store.GetAvgRecentTimesResult, error)
+}
+
+type NetworkSystemEventCreatorStakingSeqStore interface {
+	FindByHeight(int64) (*model.StakingSeq, error)
+}
+
+type networkSystemEventCreatorTask struct {
+	cfg               *config.Config
+	rules             []config.SystemEventRule
+	validatorSeqStore NetworkSystemEventCreatorValidatorSeqStore
+	blockSeqStore     NetworkSystemEventCreatorBlockSeqStore
+	stakingSeqStore   NetworkSystemEventCreatorStakingSeqStore
+
+	metricObserver metrics.Observer
+}
+
+func (t *networkSystemEventCreatorTask) GetName() string {
+	return TaskNameNetworkSystemEventCreator
+}
+
+func (t *networkSystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	timer := metrics.NewTimer(t.metricObserver)
+	defer timer.ObserveDuration()
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", "Analyzer", t.GetName(), payload.CurrentHeight))
+
+	// Validator sequences are loaded once per height, rules of the same metric share them
+	heightValidatorSequences := map[int64][]model.ValidatorSeq{
+		payload.CurrentHeight: append(payload.NewValidatorSequences, payload.UpdatedValidatorSequences...),
+	}
+
+	for _, rule := range t.rules {
+		var newSystemEvent *model.SystemEvent
+		var err error
+
+		switch rule.Metric {
+		case config.SystemEventMetricValidatorSetSizeChange:
+			newSystemEvent, err = t.getValidatorSetSizeChange(payload, rule, heightValidatorSequences)
+		case config.SystemEventMetricTotalActiveEscrowChange:
+			newSystemEvent, err = t.getTotalActiveEscrowChange(payload, rule, heightValidatorSequences)
+		case config.SystemEventMetricAvgBlockTime:
+			newSystemEvent, err = t.getAvgBlockTime(payload, rule)
+		case config.SystemEventMetricCommonPoolChange:
+			newSystemEvent, err = t.getCommonPoolChange(payload, rule)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if newSystemEvent != nil {
+			logger.Debug(fmt.Sprintf("%s for network occured [kind=%s]", rule.Metric, newSystemEvent.Kind))
+			payload.SystemEvents = append(payload.SystemEvents, newSystemEvent)
+		}
+	}
+	return nil
+}
+
+// getValidatorSetSizeChange returns system event when number of validators changed since previous height
+func (t *networkSystemEventCreatorTask) getValidatorSetSizeChange(payload *payload, rule config.SystemEventRule, heightValidatorSequences map[int64][]model.ValidatorSeq) (*model.SystemEvent, error) {
+	currValidatorSequences, ok, err := t.getValidatorSequences(heightValidatorSequences, payload.CurrentHeight)
+	if err != nil || !ok {
+		return nil, err
+	}
+	prevValidatorSequences, ok, err := t.getValidatorSequences(heightValidatorSequences, payload.CurrentHeight-1)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	change := len(currValidatorSequences) - len(prevValidatorSequences)
+	if !rule.Matches(math.Abs(float64(change))) {
+		return nil, nil
+	}
+
+	return t.newSystemEvent(payload, model.SystemEventKind(rule.Kind), systemEventRawData{
+		"before": len(prevValidatorSequences),
+		"after":  len(currValidatorSequences),
+		"change": change,
+	})
+}
+
+// getTotalActiveEscrowChange returns system event when change rate of total active escrow within the window started to match the rule
+func (t *networkSystemEventCreatorTask) getTotalActiveEscrowChange(payload *payload, rule config.SystemEventRule, heightValidatorSequences map[int64][]model.ValidatorSeq) (*model.SystemEvent, error) {
+	currTotal, windowStartTotal, ok, err := t.getTotalActiveEscrowInWindow(heightValidatorSequences, payload.CurrentHeight, rule.Window)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	changeRate := getRoundedChangeRate(currTotal, windowStartTotal)
+	if !rule.Matches(math.Abs(changeRate)) {
+		return nil, nil
+	}
+
+	prevTotal, prevWindowStartTotal, ok, err := t.getTotalActiveEscrowInWindow(heightValidatorSequences, payload.CurrentHeight-1, rule.Window)
+	if err != nil {
+		return nil, err
+	}
+	if ok && rule.Matches(math.Abs(getRoundedChangeRate(prevTotal, prevWindowStartTotal))) {
+		return nil, nil
+	}
+
+	return t.newSystemEvent(payload, model.SystemEventKind(rule.Kind), systemEventRawData{
+		"before": windowStartTotal.String(),
+		"after":  currTotal.String(),
+		"change": changeRate,
+		"window": rule.Window,
+	})
+}
+
+// getTotalActiveEscrowInWindow returns total active escrow at height and at the start of the window ending at height
+func (t *networkSystemEventCreatorTask) getTotalActiveEscrowInWindow(heightValidatorSequences map[int64][]model.ValidatorSeq, height int64, window int64) (*big.Int, *big.Int, bool, error) {
+	if height-window < t.cfg.FirstBlockHeight {
+		return nil, nil, false, nil
+	}
+
+	validatorSequences, ok, err := t.getValidatorSequences(heightValidatorSequences, height)
+	if err != nil || !ok {
+		return nil, nil, false, err
+	}
+	windowStartValidatorSequences, ok, err := t.getValidatorSequences(heightValidatorSequences, height-window)
+	if err != nil || !ok {
+		return nil, nil, false, err
+	}
+
+	return t.getTotalActiveEscrow(validatorSequences), t.getTotalActiveEscrow(windowStartValidatorSequences), true, nil
+}
+
+func (t *networkSystemEventCreatorTask) getTotalActiveEscrow(validatorSequences []model.ValidatorSeq) *big.Int {
+	total := new(big.Int)
+	for _, validatorSequence := range validatorSequences {
+		total.Add(total, &validatorSequence.ActiveEscrowBalance.Int)
+	}
+	return total
+}
+
+// getAvgBlockTime returns system event when average block time of the most recent blocks started to match the rule.
+// Current block is not persisted yet, so it extends the window of stored blocks. Reindexed heights are skipped
+func (t *networkSystemEventCreatorTask) getAvgBlockTime(payload *payload, rule config.SystemEventRule) (*model.SystemEvent, error) {
+	avgTimes, err := t.blockSeqStore.GetAvgRecentTimes(rule.Window)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if avgTimes.Count == 0 || avgTimes.EndHeight >= payload.CurrentHeight {
+		return nil, nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339Nano, avgTimes.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	avg := payload.Syncable.Time.Sub(startTime).Seconds() / float64(avgTimes.Count)
+	if !rule.Matches(avg) || rule.Matches(avgTimes.Avg) {
+		return nil, nil
+	}
+
+	return t.newSystemEvent(payload, model.SystemEventKind(rule.Kind), systemEventRawData{
+		"before": avgTimes.Avg,
+		"after":  avg,
+		"window": rule.Window,
+	})
+}
+
+// getCommonPoolChange returns system event when change rate of common pool since previous height matches the rule
+func (t *networkSystemEventCreatorTask) getCommonPoolChange(payload *payload, rule config.SystemEventRule) (*model.SystemEvent, error) {
+	if payload.RawStakingState == nil || payload.CurrentHeight <= t.cfg.FirstBlockHeight {
+		return nil, nil
+	}
+
+	prevStakingSequence, err := t.stakingSeqStore.FindByHeight(payload.CurrentHeight - 1)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	currCommonPool := types.NewQuantityFromBytes(payload.RawStakingState.GetCommonPool())
+	changeRate := getRoundedChangeRate(&currCommonPool.Int, &prevStakingSequence.CommonPool.Int)
+	if !rule.Matches(math.Abs(changeRate)) {
+		return nil, nil
+	}
+
+	return t.newSystemEvent(payload, model.SystemEventKind(rule.Kind), systemEventRawData{
+		"before": prevStakingSequence.CommonPool.String(),
+		"after":  currCommonPool.String(),
+		"change": changeRate,
+	})
+}
+
+// getValidatorSequences returns validator sequences at height, false is returned when height is not indexed
+func (t *networkSystemEventCreatorTask) getValidatorSequences(heightValidatorSequences map[int64][]model.ValidatorSeq, height int64) ([]model.ValidatorSeq, bool, error) {
+	if validatorSequences, ok := heightValidatorSequences[height]; ok {
+		return validatorSequences, len(validatorSequences) > 0, nil
+	}
+	if height < t.cfg.FirstBlockHeight {
+		return nil, false, nil
+	}
+
+	validatorSequences, err := t.validatorSeqStore.FindByHeight(height)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return nil, false, err
+		}
+		validatorSequences = nil
+	}
+
+	heightValidatorSequences[height] = validatorSequences
+	return validatorSequences, len(validatorSequences) > 0, nil
+}
+
+func (t *networkSystemEventCreatorTask) newSystemEvent(payload *payload, kind model.SystemEventKind, data systemEventRawData) (*model.SystemEvent, error) {
+	marshaledData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SystemEvent{
+		Height: payload.Syncable.Height,
+		Time:   payload.Syncable.Time,
+		Actor:  model.SystemEventActorNetwork,
+		Kind:   kind,
+		Data:   types.Jsonb{RawMessage: marshaledData},
+	}, nil
+}
+
+// NewDelegatorSystemEventCreatorTask creates system events of delegators from escrow events, debonding delegations and rewards.
+// Debonding delegations are tracked from heights indexed by startVersion onwards
+func NewDelegatorSystemEventCreatorTask(startVersion int64, d DelegatorSystemEventCreatorDebondingStore, b DelegatorSystemEventCreatorBalanceStore, s DelegatorSystemEventCreatorSyncableStore) *delegatorSystemEventCreatorTask {
+	return &delegatorSystemEventCreatorTask{
+		startVersion:   startVersion,
+		debondingStore: d,
+		balanceStore:   b,
+		syncableStore:  s,
+		metricObserver: indexerTaskDuration.WithLabels(TaskNameDelegatorSystemEventCreator),
+	}
+}
+
+type DelegatorSystemEventCreatorDebondingStore interface {
+	FindActiveAt(int64) ([]model.DebondingDelegation, error)
+}
+
+type DelegatorSystemEventCreatorBalanceStore interface {
+	FindRewardTotals(time.Time, time.Time) ([]store.RewardTotalRow, error)
+}
+
+type DelegatorSystemEventCreatorSyncableStore interface {
+	FindByHeight(int64) (*model.Syncable, error)
+}
+
+type delegatorSystemEventCreatorTask struct {
+	startVersion int64
+
+	debondingStore DelegatorSystemEventCreatorDebondingStore
+	balanceStore   DelegatorSystemEventCreatorBalanceStore
+	syncableStore  DelegatorSystemEventCreatorSyncableStore
+
+	metricObserver metrics.Observer
+}
+
+func (t *delegatorSystemEventCreatorTask) GetName() string {
+	return TaskNameDelegatorSystemEventCreator
+}
+
+func (t *delegatorSystemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	timer := metrics.NewTimer(t.metricObserver)
+	defer timer.ObserveDuration()
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", "Analyzer", t.GetName(), payload.CurrentHeight))
+
+	delegationAddedSystemEvents, err := t.getDelegationAddedSystemEvents(payload)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, delegationAddedSystemEvents...)
+
+	prevSyncable, err := t.syncableStore.FindByHeight(payload.CurrentHeight - 1)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return err
+		}
+		prevSyncable = nil
+	}
+
+	debondingSystemEvents, err := t.getDebondingSystemEvents(payload, prevSyncable)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, debondingSystemEvents...)
+
+	if prevSyncable == nil {
+		return nil
+	}
+
+	dailyRewardSystemEvents, err := t.getDailyRewardSystemEvents(payload, prevSyncable)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, dailyRewardSystemEvents...)
+
+	return nil
+}
+
+// getDelegationAddedSystemEvents returns system event for every delegator which added escrow at current height.
+// Escrow added by common pool is a reward or commission, not a delegation
+func (t *delegatorSystemEventCreatorTask) getDelegationAddedSystemEvents(payload *payload) ([]*model.SystemEvent, error) {
+	var delegators []string
+	totals := make(map[string]*big.Int)
+	delegations := make(map[string][]systemEventRawData)
+	for _, rawEvent := range payload.RawEscrowEvents.GetAdd() {
+		delegator := rawEvent.GetOwner()
+		if delegator == payload.CommonPoolAddress {
+			continue
+		}
+
+		if _, ok := totals[delegator]; !ok {
+			delegators = append(delegators, delegator)
+			totals[delegator] = new(big.Int)
+		}
+
+		amount := types.NewQuantityFromBytes(rawEvent.GetAmount())
+		totals[delegator].Add(totals[delegator], &amount.Int)
+		delegations[delegator] = append(delegations[delegator], systemEventRawData{
+			"validator": rawEvent.GetEscrow(),
+			"amount":    amount.String(),
+		})
+	}
+
+	var systemEvents []*model.SystemEvent
+	for _, delegator := range delegators {
+		newSystemEvent, err := t.newSystemEvent(payload, delegator, model.SystemEventDelegationAdded, systemEventRawData{
+			"amount":      totals[delegator].String(),
+			"delegations": delegations[delegator],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug(fmt.Sprintf("delegation added by address %s [amount=%s]", delegator, totals[delegator].String()))
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+	return systemEvents, nil
+}
+
+// getDebondingSystemEvents compares debonding delegations with previous height and returns system events
+// for delegators which started reclaiming escrow and delegators which debonding finished, so funds are liquid.
+// Changed debonding delegations are stored in payload. When previous height was not indexed with debonding delegations yet,
+// all current ones are stored without system events
+func (t *delegatorSystemEventCreatorTask) getDebondingSystemEvents(payload *payload, prevSyncable *model.Syncable) ([]*model.SystemEvent, error) {
+	height := payload.CurrentHeight
+	currDebondingDelegations, err := StakingStateToDebondingDelegations(height, payload.RawStakingState)
+	if err != nil {
+		return nil, err
+	}
+
+	if prevSyncable == nil || prevSyncable.IndexVersion < t.startVersion {
+		payload.DebondingDelegations = currDebondingDelegations
+		return nil, nil
+	}
+
+	prevDebondingDelegations, err := t.debondingStore.FindActiveAt(height - 1)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+
+	started := t.getMissingDebondingDelegations(currDebondingDelegations, prevDebondingDelegations)
+	completed := t.getMissingDebondingDelegations(prevDebondingDelegations, currDebondingDelegations)
+	for i := range completed {
+		completed[i].EndHeight = &height
+	}
+	payload.DebondingDelegations = append(started, completed...)
+
+	ledger := payload.RawStakingState.GetLedger()
+	startedSystemEvents, err := t.getDebondingSystemEventsOfKind(payload, model.SystemEventReclaimStarted, started, func(d model.DebondingDelegation) systemEventRawData {
+		data := t.getDebondingData(d)
+		if account, ok := ledger[d.ValidatorUID]; ok {
+			data["amount"] = t.getDebondingAmount(d, account.GetEscrow().GetDebonding().GetBalance(), account.GetEscrow().GetDebonding().GetTotalShares())
+		}
+		return data
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	completedSystemEvents, err := t.getDebondingSystemEventsOfKind(payload, model.SystemEventDebondingCompleted, completed, t.getDebondingData)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(startedSystemEvents, completedSystemEvents...), nil
+}
+
+// getMissingDebondingDelegations returns debonding delegations which do not have an equal counterpart in others
+func (t *delegatorSystemEventCreatorTask) getMissingDebondingDelegations(debondingDelegations []model.DebondingDelegation, others []model.DebondingDelegation) []model.DebondingDelegation {
+	key := func(d model.DebondingDelegation) string {
+		return fmt.Sprintf("%s/%s/%d/%s", d.ValidatorUID, d.DelegatorUID, d.DebondEnd, d.Shares.String())
+	}
+
+	counts := make(map[string]int)
+	for _, other := range others {
+		counts[key(other)]++
+	}
+
+	var missing []model.DebondingDelegation
+	for _, debondingDelegation := range debondingDelegations {
+		k := key(debondingDelegation)
+		if counts[k] > 0 {
+			counts[k]--
+			continue
+		}
+		missing = append(missing, debondingDelegation)
+	}
+	return missing
+}
+
+// getDebondingSystemEventsOfKind returns single system event for every delegator, with its debonding delegations listed in data
+func (t *delegatorSystemEventCreatorTask) getDebondingSystemEventsOfKind(payload *payload, kind model.SystemEventKind, debondingDelegations []model.DebondingDelegation, getData func(model.DebondingDelegation) systemEventRawData) ([]*model.SystemEvent, error) {
+	var delegators []string
+	byDelegator := make(map[string][]systemEventRawData)
+	for _, debondingDelegation := range debondingDelegations {
+		if _, ok := byDelegator[debondingDelegation.DelegatorUID]; !ok {
+			delegators = append(delegators, debondingDelegation.DelegatorUID)
+		}
+		byDelegator[debondingDelegation.DelegatorUID] = append(byDelegator[debondingDelegation.DelegatorUID], getData(debondingDelegation))
+	}
+
+	var systemEvents []*model.SystemEvent
+	for _, delegator := range delegators {
+		newSystemEvent, err := t.newSystemEvent(payload, delegator, kind, systemEventRawData{
+			"debondings": byDelegator[delegator],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug(fmt.Sprintf("%s for address %s occured", kind, delegator))
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+	return systemEvents, nil
+}
+
+func (t *delegatorSystemEventCreatorTask) getDebondingData(debondingDelegation model.DebondingDelegation) systemEventRawData {
+	return systemEventRawData{
+		"validator":  debondingDelegation.ValidatorUID,
+		"shares":     debondingDelegation.Shares.String(),
+		"debond_end": debondingDelegation.DebondEnd,
+	}
+}
+
+// getDebondingAmount returns value of debonding delegation shares in debonding pool of validator
+func (t *delegatorSystemEventCreatorTask) getDebondingAmount(debondingDelegation model.DebondingDelegation, rawBalance []byte, rawTotalShares []byte) string {
+	totalShares := types.NewQuantityFromBytes(rawTotalShares)
+	if totalShares.IsZero() {
+		return "0"
+	}
+
+	balance := types.NewQuantityFromBytes(rawBalance)
+	amount := new(big.Int).Mul(&debondingDelegation.Shares.Int, &balance.Int)
+	return amount.Quo(amount, &totalShares.Int).String()
+}
+
+// getDailyRewardSystemEvents returns system event with rewards received by every delegator during previous day.
+// Events are created at the first height of a day
+func (t *delegatorSystemEventCreatorTask) getDailyRewardSystemEvents(payload *payload, prevSyncable *model.Syncable) ([]*model.SystemEvent, error) {
+	currDay := t.getDay(payload.Syncable.Time.Time)
+	prevDay := t.getDay(prevSyncable.Time.Time)
+	if !currDay.After(prevDay) {
+		return nil, nil
+	}
+
+	rewardTotals, err := t.balanceStore.FindRewardTotals(prevDay, currDay)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var systemEvents []*model.SystemEvent
+	for _, rewardTotal := range rewardTotals {
+		if rewardTotal.Amount.IsZero() {
+			continue
+		}
+
+		newSystemEvent, err := t.newSystemEvent(payload, rewardTotal.Address, model.SystemEventDailyReward, systemEventRawData{
+			"date":   prevDay.Format("2006-01-02"),
+			"amount": rewardTotal.Amount.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+
+	logger.Debug(fmt.Sprintf("daily rewards for %s [count=%d]", prevDay.Format("2006-01-02"), len(systemEvents)))
+	return systemEvents, nil
+}
+
+func (t *delegatorSystemEventCreatorTask) getDay(tm time.Time) time.Time {
+	year, month, day := tm.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (t *delegatorSystemEventCreatorTask) newSystemEvent(payload *payload, actor string, kind model.SystemEventKind, data systemEventRawData) (*model.SystemEvent, error) {
+	marshaledData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SystemEvent{
+		Height: payload.Syncable.Height,
+		Time:   payload.Syncable.Time,
+		Actor:  actor,
+		Kind:   kind,
+		Data:   types.Jsonb{RawMessage: marshaledData},
+	}, nil
+}
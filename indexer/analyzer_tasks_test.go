@@ -3,9 +3,14 @@ package indexer
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
 	"github.com/figment-networks/oasishub-indexer/config"
 	mock_indexer "github.com/figment-networks/oasishub-indexer/mock/indexer"
 	"github.com/figment-networks/oasishub-indexer/model"
@@ -34,10 +39,7 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 		defer ctrl.Finish()
 
 		validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
-
-		MaxValidatorSequences = 10
-		MissedInRowThreshold = 5
-		MissedForMaxThreshold = 5
+		uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
 		prevHeightValidatorSequences := []model.ValidatorSeq{
 			newValidatorSeq(testValidatorAddress, 1000, 0, true),
@@ -70,11 +72,12 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 
 		validatorSeqStoreMock.EXPECT().FindByHeight(gomock.Any()).Return(prevHeightValidatorSequences, nil).Times(1)
 		gomock.InOrder(
-			validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(lastValidatorSeqsForValidator1, nil),
-			validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(lastValidatorSeqsForValidator2, nil),
+			validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(withPrecedingHeights(lastValidatorSeqsForValidator1), nil),
+			validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(withPrecedingHeights(lastValidatorSeqsForValidator2), nil),
 		)
+		uptimeStoreMock.EXPECT().FindByAddresses(gomock.Any()).Return(nil, nil).Times(1)
 
-		task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+		task := NewSystemEventCreatorTask(testCfg, testSystemEventRules(10, 5, 5), validatorSeqStoreMock, uptimeStoreMock)
 		err := task.Run(ctx, payload)
 		if err != nil {
 			t.Errorf("unexpected result, run should not return error: %v", err)
@@ -91,12 +94,13 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 		defer ctrl.Finish()
 
 		validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+		uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
 		payload := testPayload()
 
 		validatorSeqStoreMock.EXPECT().FindByHeight(gomock.Any()).Return(nil, ErrValidatorSeqFindByHeight).Times(1)
 
-		task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+		task := NewSystemEventCreatorTask(testCfg, testSystemEventRules(10, 5, 5), validatorSeqStoreMock, uptimeStoreMock)
 		err := task.Run(ctx, payload)
 		if err == nil {
 			t.Errorf("unexpected result, run should return error")
@@ -108,6 +112,7 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 		defer ctrl.Finish()
 
 		validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+		uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
 		lastValidatorSeqsForValidator1 := []model.ValidatorSeq{
 			newValidatorSeq(testValidatorAddress, 1000, 0, false),
@@ -118,9 +123,10 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 		}
 
 		validatorSeqStoreMock.EXPECT().FindByHeight(gomock.Any()).Return(nil, store.ErrNotFound).Times(1)
-		validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(lastValidatorSeqsForValidator1, nil).Times(1)
+		validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(withPrecedingHeights(lastValidatorSeqsForValidator1), nil).Times(1)
+		uptimeStoreMock.EXPECT().FindByAddresses(gomock.Any()).Return(nil, nil).Times(1)
 
-		task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+		task := NewSystemEventCreatorTask(testCfg, testSystemEventRules(10, 5, 5), validatorSeqStoreMock, uptimeStoreMock)
 		err := task.Run(ctx, payload)
 		if err != nil {
 			t.Errorf("unexpected result, run should not return error: %v", err)
@@ -137,6 +143,7 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 		defer ctrl.Finish()
 
 		validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+		uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
 		prevHeightValidatorSequences := []model.ValidatorSeq{
 			newValidatorSeq(testValidatorAddress, 1000, 0, true),
@@ -148,8 +155,9 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 
 		validatorSeqStoreMock.EXPECT().FindByHeight(gomock.Any()).Return(prevHeightValidatorSequences, nil).Times(1)
 		validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any()).Return(nil, ErrCouldNotFindByAddress).Times(1)
+		uptimeStoreMock.EXPECT().FindByAddresses(gomock.Any()).Return(nil, nil).Times(1)
 
-		task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+		task := NewSystemEventCreatorTask(testCfg, testSystemEventRules(10, 5, 5), validatorSeqStoreMock, uptimeStoreMock)
 		err := task.Run(ctx, payload)
 		if err == nil {
 			t.Errorf("unexpected result, run should return error")
@@ -192,6 +200,7 @@ func TestSystemEventCreatorTask_getValueChangeSystemEvents(t *testing.T) {
 			defer ctrl.Finish()
 
 			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+			uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
 			var activeEscrowBalanceBefore int64 = 1000
 			activeEscrowBalanceAfter := float64(activeEscrowBalanceBefore) + (float64(activeEscrowBalanceBefore) * tt.activeEscrowBalanceChangeRate / 100)
@@ -206,7 +215,7 @@ func TestSystemEventCreatorTask_getValueChangeSystemEvents(t *testing.T) {
 				newValidatorSeq(testValidatorAddress, int64(activeEscrowBalanceAfter), int64(commissionAfter), true),
 			}
 
-			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+			task := NewSystemEventCreatorTask(testCfg, config.DefaultSystemEventRules(), validatorSeqStoreMock, uptimeStoreMock)
 			createdSystemEvents, _ := task.getValueChangeSystemEvents(currHeightValidatorSequences, prevHeightValidatorSequences)
 
 			if len(createdSystemEvents) != tt.expectedCount {
@@ -221,6 +230,88 @@ func TestSystemEventCreatorTask_getValueChangeSystemEvents(t *testing.T) {
 	}
 }
 
+func TestSystemEventCreatorTask_getValueChangeSystemEvents_LargeValues(t *testing.T) {
+	tests := []struct {
+		description  string
+		before       string
+		after        string
+		expectedKind model.SystemEventKind
+		expectedData string
+	}{
+		{
+			description:  "returns activeEscrowBalanceChange2 system event when balance exceeding int64 increases by 1",
+			before:       "20000000000000000000",
+			after:        "20200000000000000000",
+			expectedKind: model.SystemEventActiveEscrowBalanceChange2,
+			expectedData: `{"after":"20200000000000000000","before":"20000000000000000000","change":"-1.0"}`,
+		},
+		{
+			description:  "returns activeEscrowBalanceChange1 system event when mainnet sized balance increases by 0.1",
+			before:       "1234567890123456789012",
+			after:        "1235802458013580245801",
+			expectedKind: model.SystemEventActiveEscrowBalanceChange1,
+			expectedData: `{"after":"1235802458013580245801","before":"1234567890123456789012","change":"-0.1"}`,
+		},
+		{
+			description:  "returns activeEscrowBalanceChange3 system event when balance of 2^64 decreases by half",
+			before:       "18446744073709551616",
+			after:        "9223372036854775808",
+			expectedKind: model.SystemEventActiveEscrowBalanceChange3,
+			expectedData: `{"after":"9223372036854775808","before":"18446744073709551616","change":"50.0"}`,
+		},
+		{
+			description: "returns no system events when balance exceeding int64 changes by one base unit",
+			before:      "9223372036854775807000",
+			after:       "9223372036854775807001",
+		},
+		{
+			description: "returns no system events when balance exceeding int64 haven't changed",
+			before:      "340282366920938463463374607431768211456",
+			after:       "340282366920938463463374607431768211456",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+			uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
+
+			prevHeightValidatorSequences := []model.ValidatorSeq{
+				newLargeBalanceValidatorSeq(t, testValidatorAddress, tt.before),
+			}
+			currHeightValidatorSequences := []model.ValidatorSeq{
+				newLargeBalanceValidatorSeq(t, testValidatorAddress, tt.after),
+			}
+
+			task := NewSystemEventCreatorTask(testCfg, config.DefaultSystemEventRules(), validatorSeqStoreMock, uptimeStoreMock)
+			createdSystemEvents, err := task.getValueChangeSystemEvents(currHeightValidatorSequences, prevHeightValidatorSequences)
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if tt.expectedKind == "" {
+				if len(createdSystemEvents) != 0 {
+					t.Errorf("unexpected system event count, want %v; got %v", 0, len(createdSystemEvents))
+				}
+				return
+			}
+
+			if len(createdSystemEvents) != 1 {
+				t.Fatalf("unexpected system event count, want %v; got %v", 1, len(createdSystemEvents))
+			}
+			if createdSystemEvents[0].Kind != tt.expectedKind {
+				t.Errorf("unexpected system event kind, want %v; got %v", tt.expectedKind, createdSystemEvents[0].Kind)
+			}
+			if string(createdSystemEvents[0].Data.RawMessage) != tt.expectedData {
+				t.Errorf("unexpected system event data, want %v; got %v", tt.expectedData, string(createdSystemEvents[0].Data.RawMessage))
+			}
+		})
+	}
+}
+
 func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -284,8 +375,9 @@ func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testin
 			defer ctrl.Finish()
 
 			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+			uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
-			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+			task := NewSystemEventCreatorTask(testCfg, config.DefaultSystemEventRules(), validatorSeqStoreMock, uptimeStoreMock)
 			createdSystemEvents, _ := task.getActiveSetPresenceChangeSystemEvents(tt.currHeightList, tt.prevHeightList)
 
 			if len(createdSystemEvents) != tt.expectedCount {
@@ -302,6 +394,164 @@ func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testin
 	}
 }
 
+func TestSystemEventCreatorTask_getSlashedSystemEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+	uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
+
+	payload := testPayload()
+	payload.ParsedSlashes = []ParsedSlash{
+		{EscrowAddress: testValidatorAddress, Pool: model.EscrowPoolActive, Amount: types.NewQuantityFromInt64(50), Balance: types.NewQuantityFromInt64(950)},
+		{EscrowAddress: testValidatorAddress, Pool: model.EscrowPoolDebonding, Amount: types.NewQuantityFromInt64(10), Balance: types.NewQuantityFromInt64(30)},
+		{EscrowAddress: "address1", Pool: model.EscrowPoolActive, Amount: types.NewQuantityFromInt64(20), Balance: types.NewQuantityFromInt64(80)},
+	}
+	currHeightValidatorSequences := []model.ValidatorSeq{
+		newValidatorSeq(testValidatorAddress, 950, 0, true),
+	}
+
+	task := NewSystemEventCreatorTask(testCfg, config.DefaultSystemEventRules(), validatorSeqStoreMock, uptimeStoreMock)
+	createdSystemEvents, err := task.getSlashedSystemEvents(payload, currHeightValidatorSequences)
+	if err != nil {
+		t.Fatalf("unexpected error, want %v; got %v", nil, err)
+	}
+
+	expectedActors := []string{testValidatorAddress, "address1"}
+	expectedData := []string{
+		`{"amount":"60","fraction":0.057692307692307696,"pools":[{"amount":"50","balance":"950","fraction":0.05,"pool":"active"},{"amount":"10","balance":"30","fraction":0.25,"pool":"debonding"}]}`,
+		`{"amount":"20","fraction":0.2,"pools":[{"amount":"20","balance":"80","fraction":0.2,"pool":"active"}]}`,
+	}
+	if len(createdSystemEvents) != len(expectedData) {
+		t.Fatalf("unexpected system event count, want %v; got %v", len(expectedData), len(createdSystemEvents))
+	}
+
+	for i, systemEvent := range createdSystemEvents {
+		if systemEvent.Kind != model.SystemEventSlashed {
+			t.Errorf("unexpected system event kind, want %v; got %v", model.SystemEventSlashed, systemEvent.Kind)
+		}
+		if systemEvent.Actor != expectedActors[i] {
+			t.Errorf("unexpected system event actor, want %v; got %v", expectedActors[i], systemEvent.Actor)
+		}
+		if systemEvent.Height != testHeight {
+			t.Errorf("unexpected system event height, want %v; got %v", testHeight, systemEvent.Height)
+		}
+		if string(systemEvent.Data.RawMessage) != expectedData[i] {
+			t.Errorf("unexpected system event data, want %v; got %v", expectedData[i], string(systemEvent.Data.RawMessage))
+		}
+	}
+}
+
+func TestSystemEventCreatorTask_getLifecycleSystemEvents(t *testing.T) {
+	tests := []struct {
+		description   string
+		new           []model.ValidatorAgg
+		previous      []model.ValidatorAgg
+		updated       []model.ValidatorAgg
+		expectedKinds []model.SystemEventKind
+	}{
+		{
+			description:   "returns validator_registered system event for new validator",
+			new:           []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node", "tm")},
+			expectedKinds: []model.SystemEventKind{model.SystemEventValidatorRegistered},
+		},
+		{
+			description: "returns no system events when keys did not change",
+			previous:    []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node", "tm")},
+			updated:     []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node", "tm")},
+		},
+		{
+			description: "returns no system events when stored node was not indexed yet",
+			previous:    []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "", "tm")},
+			updated:     []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node", "tm")},
+		},
+		{
+			description:   "returns tendermint_address_changed and node_changed system events when keys were rotated",
+			previous:      []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node", "tm")},
+			updated:       []model.ValidatorAgg{newLifecycleValidatorAgg("entity", "node2", "tm2")},
+			expectedKinds: []model.SystemEventKind{model.SystemEventTendermintAddressChanged, model.SystemEventNodeChanged},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+			uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
+
+			payload := testPayload()
+			payload.NewAggregatedValidators = tt.new
+			payload.PreviousAggregatedValidators = tt.previous
+			payload.UpdatedAggregatedValidators = tt.updated
+
+			task := NewSystemEventCreatorTask(testCfg, config.DefaultSystemEventRules(), validatorSeqStoreMock, uptimeStoreMock)
+			createdSystemEvents, err := task.getLifecycleSystemEvents(payload, []model.ValidatorSeq{newValidatorSeq(testValidatorAddress, 1000, 0, true)})
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if len(createdSystemEvents) != len(tt.expectedKinds) {
+				t.Fatalf("unexpected system event count, want %v; got %v", len(tt.expectedKinds), len(createdSystemEvents))
+			}
+
+			for i, kind := range tt.expectedKinds {
+				if createdSystemEvents[i].Kind != kind {
+					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
+				}
+				if createdSystemEvents[i].Actor != testValidatorAddress {
+					t.Errorf("unexpected system event actor, want %v; got %v", testValidatorAddress, createdSystemEvents[i].Actor)
+				}
+			}
+		})
+	}
+}
+
+func TestSystemEventCreatorTask_getVotingPowerRankSystemEvents(t *testing.T) {
+	rules := []config.SystemEventRule{
+		{Kind: "entered_top_2", Metric: config.SystemEventMetricVotingPowerRank, Comparison: config.ComparisonLte, Thresholds: []float64{2}},
+		{Kind: "left_top_2", Metric: config.SystemEventMetricVotingPowerRank, Comparison: config.ComparisonGt, Thresholds: []float64{2}},
+	}
+
+	prevHeightList := []model.ValidatorSeq{
+		newVotingPowerValidatorSeq("address1", 30),
+		newVotingPowerValidatorSeq("address2", 20),
+		newVotingPowerValidatorSeq("address3", 10),
+	}
+	currHeightList := []model.ValidatorSeq{
+		newVotingPowerValidatorSeq("address1", 30),
+		newVotingPowerValidatorSeq("address2", 10),
+		newVotingPowerValidatorSeq("address3", 20),
+		newVotingPowerValidatorSeq("address4", 5),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+	uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
+
+	task := NewSystemEventCreatorTask(testCfg, rules, validatorSeqStoreMock, uptimeStoreMock)
+	createdSystemEvents, err := task.getVotingPowerRankSystemEvents(currHeightList, prevHeightList)
+	if err != nil {
+		t.Fatalf("unexpected error, want %v; got %v", nil, err)
+	}
+
+	expected := map[string]model.SystemEventKind{
+		"address2": "left_top_2",
+		"address3": "entered_top_2",
+	}
+	if len(createdSystemEvents) != len(expected) {
+		t.Fatalf("unexpected system event count, want %v; got %v", len(expected), len(createdSystemEvents))
+	}
+	for _, systemEvent := range createdSystemEvents {
+		if kind, ok := expected[systemEvent.Actor]; !ok || systemEvent.Kind != kind {
+			t.Errorf("unexpected system event for %s, want %v; got %v", systemEvent.Actor, kind, systemEvent.Kind)
+		}
+	}
+}
+
 func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 	tests := []struct {
 		description           string
@@ -311,9 +561,12 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 		prevHeightList        []model.ValidatorSeq
 		currHeightList        []model.ValidatorSeq
 		lastForValidatorList  [][]model.ValidatorSeq
+		stored                []model.ValidatorUptime
+		storedHeight          int64
 		errs                  []error
 		expectedCount         int
 		expectedKinds         []model.SystemEventKind
+		expectedUptimes       int
 		expectedErr           error
 	}{
 		{
@@ -398,7 +651,7 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNConsecutive},
 		},
 		{
-			description:           "returns no missed_n_consecutive system events when validator missed >= 3 blocks in a row in the past but current is validated",
+			description:           "returns recovered system event when validator missed >= 3 blocks in a row in the past but current is validated",
 			maxValidatorSequences: 5,
 			missedInRowThreshold:  3,
 			missedForMaxThreshold: 5,
@@ -416,7 +669,8 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 					newValidatorSeq(testValidatorAddress, 1000, 0, true),
 				},
 			},
-			expectedCount: 0,
+			expectedCount: 1,
+			expectedKinds: []model.SystemEventKind{model.SystemEventRecovered},
 		},
 		{
 			description:           "returns one missed_n_of_m system events when validator missed 3 blocks",
@@ -552,7 +806,7 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 			expectedErr:   ErrCouldNotFindByAddress,
 		},
 		{
-			description:           "returns partial system events when second call to FindLastByAddress fails with ErrNotFound",
+			description:           "returns system events when second validator does not have any previous sequences in db",
 			maxValidatorSequences: 3,
 			missedInRowThreshold:  50,
 			missedForMaxThreshold: 3,
@@ -577,6 +831,87 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 			expectedCount: 1,
 			expectedKinds: []model.SystemEventKind{model.SystemEventMissedNofM},
 		},
+		{
+			description:           "returns system events for validators after one that validated current height",
+			maxValidatorSequences: 3,
+			missedInRowThreshold:  50,
+			missedForMaxThreshold: 3,
+			prevHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, true),
+				newValidatorSeq("address1", 1000, 0, true),
+			},
+			currHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, true),
+				newValidatorSeq("address1", 1000, 0, false),
+			},
+			lastForValidatorList: [][]model.ValidatorSeq{
+				{
+					newValidatorSeq(testValidatorAddress, 1000, 0, true),
+				},
+				{
+					newValidatorSeq("address1", 1000, 0, false),
+					newValidatorSeq("address1", 1000, 0, false),
+					newValidatorSeq("address1", 1000, 0, true),
+				},
+			},
+			expectedCount:   1,
+			expectedKinds:   []model.SystemEventKind{model.SystemEventMissedNofM},
+			expectedUptimes: 2,
+		},
+		{
+			description:           "returns system events using stored uptime without querying validator sequences",
+			maxValidatorSequences: 5,
+			missedInRowThreshold:  3,
+			missedForMaxThreshold: 50,
+			prevHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, false),
+			},
+			currHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, false),
+			},
+			stored: []model.ValidatorUptime{
+				newTestValidatorUptime(testValidatorAddress, 6, false, true, true),
+			},
+			expectedCount:   1,
+			expectedKinds:   []model.SystemEventKind{model.SystemEventMissedNConsecutive},
+			expectedUptimes: 1,
+		},
+		{
+			description:           "returns recovered system event using stored uptime",
+			maxValidatorSequences: 5,
+			missedInRowThreshold:  3,
+			missedForMaxThreshold: 50,
+			prevHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, false),
+			},
+			currHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, true),
+			},
+			stored: []model.ValidatorUptime{
+				newTestValidatorUptime(testValidatorAddress, 6, true, true, true, true),
+			},
+			expectedCount:   1,
+			expectedKinds:   []model.SystemEventKind{model.SystemEventRecovered},
+			expectedUptimes: 1,
+		},
+		{
+			description:           "returns no system events when height was already recorded in stored uptime",
+			maxValidatorSequences: 5,
+			missedInRowThreshold:  3,
+			missedForMaxThreshold: 3,
+			prevHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, false),
+			},
+			currHeightList: []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, 1000, 0, false),
+			},
+			stored: []model.ValidatorUptime{
+				newTestValidatorUptime(testValidatorAddress, 6, true, true, true),
+			},
+			storedHeight:    testHeight,
+			expectedCount:   0,
+			expectedUptimes: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -585,30 +920,32 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 			defer ctrl.Finish()
 
 			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+			uptimeStoreMock := mock_indexer.NewMockSystemEventCreatorUptimeStore(ctrl)
 
-			MaxValidatorSequences = tt.maxValidatorSequences
-			MissedInRowThreshold = tt.missedInRowThreshold
-			MissedForMaxThreshold = tt.missedForMaxThreshold
+			if tt.storedHeight != 0 {
+				for i := range tt.stored {
+					tt.stored[i].Height = tt.storedHeight
+				}
+			}
+			uptimeStoreMock.EXPECT().FindByAddresses(gomock.Any()).Return(tt.stored, nil).Times(1)
 
 			var mockCalls []*gomock.Call
 			for i, validatorSeqs := range tt.lastForValidatorList {
-				validatorSeq := tt.currHeightList[i]
-				if validatorSeq.PrecommitValidated == nil || !*validatorSeq.PrecommitValidated {
-					call := validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any())
-
-					if len(tt.errs) >= i+1 && tt.errs[i] != nil {
-						call = call.Return(nil, tt.errs[i])
-					} else {
-						call = call.Return(validatorSeqs, nil)
-					}
+				call := validatorSeqStoreMock.EXPECT().FindLastByAddress(gomock.Any(), gomock.Any())
 
-					mockCalls = append(mockCalls, call)
+				if len(tt.errs) >= i+1 && tt.errs[i] != nil {
+					call = call.Return(nil, tt.errs[i])
+				} else {
+					call = call.Return(withPrecedingHeights(validatorSeqs), nil)
 				}
+
+				mockCalls = append(mockCalls, call)
 			}
 			gomock.InOrder(mockCalls...)
 
-			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
-			createdSystemEvents, err := task.getMissedBlocksSystemEvents(tt.currHeightList)
+			rules := testSystemEventRules(tt.maxValidatorSequences, tt.missedInRowThreshold, tt.missedForMaxThreshold)
+			task := NewSystemEventCreatorTask(testCfg, rules, validatorSeqStoreMock, uptimeStoreMock)
+			createdSystemEvents, uptimes, err := task.getMissedBlocksSystemEvents(tt.currHeightList)
 			if err == nil && tt.expectedErr != nil {
 				t.Errorf("should return error")
 				return
@@ -628,10 +965,28 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
 				}
 			}
+
+			if tt.expectedUptimes != 0 && len(uptimes) != tt.expectedUptimes {
+				t.Errorf("unexpected uptime count, want %v; got %v", tt.expectedUptimes, len(uptimes))
+			}
 		})
 	}
 }
 
+func testSystemEventRules(maxValidatorSequences, missedInRowThreshold, missedForMaxThreshold int64) []config.SystemEventRule {
+	rules := config.DefaultSystemEventRules()
+	for i := range rules {
+		switch rules[i].Metric {
+		case config.SystemEventMetricMissedTotal:
+			rules[i].Thresholds = []float64{float64(missedForMaxThreshold)}
+			rules[i].Window = maxValidatorSequences
+		case config.SystemEventMetricMissedInRow:
+			rules[i].Thresholds = []float64{float64(missedInRowThreshold)}
+		}
+	}
+	return rules
+}
+
 func testPayload() *payload {
 	return &payload{
 		Syncable: &model.Syncable{
@@ -642,6 +997,21 @@ func testPayload() *payload {
 	}
 }
 
+func newLifecycleValidatorAgg(entityUID string, nodeID string, tendermintAddress string) model.ValidatorAgg {
+	return model.ValidatorAgg{
+		Address:                 testValidatorAddress,
+		EntityUID:               entityUID,
+		RecentNodeID:            nodeID,
+		RecentTendermintAddress: tendermintAddress,
+	}
+}
+
+func newVotingPowerValidatorSeq(address string, votingPower int64) model.ValidatorSeq {
+	seq := newValidatorSeq(address, 1000, 0, true)
+	seq.VotingPower = votingPower
+	return seq
+}
+
 func newValidatorSeq(address string, balance int64, commission int64, validated bool) model.ValidatorSeq {
 	return model.ValidatorSeq{
 		Sequence: &model.Sequence{
@@ -654,3 +1024,619 @@ func newValidatorSeq(address string, balance int64, commission int64, validated
 		PrecommitValidated:  &validated,
 	}
 }
+
+// withPrecedingHeights sets heights of validator sequences returned by FindLastByAddress, most recent first
+func withPrecedingHeights(seqs []model.ValidatorSeq) []model.ValidatorSeq {
+	for i := range seqs {
+		seq := seqs[i]
+		seq.Sequence = &model.Sequence{
+			Height: testHeight - 1 - int64(i),
+			Time:   seq.Time,
+		}
+		seqs[i] = seq
+	}
+	return seqs
+}
+
+// newTestValidatorUptime returns uptime recorded up to previous height with given results, oldest first
+func newTestValidatorUptime(address string, size int64, missed ...bool) model.ValidatorUptime {
+	uptime := model.NewValidatorUptime(address, size)
+	uptime.Model.ID = 1
+	for i, m := range missed {
+		uptime.Record(testHeight-int64(len(missed)-i), m)
+	}
+	return *uptime
+}
+
+func newLargeBalanceValidatorSeq(t *testing.T, address string, balance string) model.ValidatorSeq {
+	b, ok := new(big.Int).SetString(balance, 10)
+	if !ok {
+		t.Fatalf("invalid balance %s", balance)
+	}
+
+	seq := newValidatorSeq(address, 0, 0, true)
+	seq.ActiveEscrowBalance = types.NewQuantity(b)
+	return seq
+}
+
+func TestNetworkSystemEventCreatorTask_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl)
+	blockSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl)
+	stakingSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl)
+
+	rules := []config.SystemEventRule{
+		{Kind: "validator_set_size_changed", Metric: config.SystemEventMetricValidatorSetSizeChange, Comparison: config.ComparisonGte, Thresholds: []float64{1}},
+		{Kind: "validator_set_size_changed_2", Metric: config.SystemEventMetricValidatorSetSizeChange, Comparison: config.ComparisonGte, Thresholds: []float64{2}},
+		{Kind: "missed_n_consecutive", Metric: config.SystemEventMetricMissedInRow, Comparison: config.ComparisonEq, Thresholds: []float64{3}},
+	}
+
+	validatorSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-1)).Return([]model.ValidatorSeq{
+		newValidatorSeq("address1", 1000, 0, true),
+	}, nil).Times(1)
+
+	payload := testPayload()
+	payload.NewValidatorSequences = []model.ValidatorSeq{
+		newValidatorSeq("address1", 1000, 0, true),
+		newValidatorSeq("address2", 1000, 0, true),
+	}
+
+	task := NewNetworkSystemEventCreatorTask(testCfg, rules, validatorSeqStoreMock, blockSeqStoreMock, stakingSeqStoreMock)
+	if err := task.Run(context.Background(), payload); err != nil {
+		t.Fatalf("unexpected error, want %v; got %v", nil, err)
+	}
+
+	if len(payload.SystemEvents) != 1 {
+		t.Fatalf("unexpected system event count, want %v; got %v", 1, len(payload.SystemEvents))
+	}
+	systemEvent := payload.SystemEvents[0]
+	if systemEvent.Kind != model.SystemEventValidatorSetSizeChanged {
+		t.Errorf("unexpected system event kind, want %v; got %v", model.SystemEventValidatorSetSizeChanged, systemEvent.Kind)
+	}
+	if systemEvent.Actor != model.SystemEventActorNetwork {
+		t.Errorf("unexpected system event actor, want %v; got %v", model.SystemEventActorNetwork, systemEvent.Actor)
+	}
+	expectedData := `{"after":2,"before":1,"change":1}`
+	if string(systemEvent.Data.RawMessage) != expectedData {
+		t.Errorf("unexpected system event data, want %v; got %v", expectedData, string(systemEvent.Data.RawMessage))
+	}
+}
+
+func TestNetworkSystemEventCreatorTask_getValidatorSetSizeChange(t *testing.T) {
+	tests := []struct {
+		description   string
+		prevCount     int
+		currCount     int
+		prevErr       error
+		expectedCount int
+	}{
+		{"returns no system events when validator set size haven't changed", 3, 3, nil, 0},
+		{"returns system event when validator joined", 3, 4, nil, 1},
+		{"returns system event when validators left", 3, 1, nil, 1},
+		{"returns no system events when previous height is not indexed", 0, 4, store.ErrNotFound, 0},
+		{"returns no system events when current height has no validators", 3, 0, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl)
+			blockSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl)
+			stakingSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl)
+
+			if tt.currCount > 0 {
+				validatorSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-1)).Return(newValidatorSeqs(tt.prevCount, 1000), tt.prevErr).Times(1)
+			}
+
+			rule := config.SystemEventRule{Kind: "validator_set_size_changed", Metric: config.SystemEventMetricValidatorSetSizeChange, Comparison: config.ComparisonGte, Thresholds: []float64{1}}
+			heightValidatorSequences := map[int64][]model.ValidatorSeq{
+				testHeight: newValidatorSeqs(tt.currCount, 1000),
+			}
+
+			task := NewNetworkSystemEventCreatorTask(testCfg, []config.SystemEventRule{rule}, validatorSeqStoreMock, blockSeqStoreMock, stakingSeqStoreMock)
+			systemEvent, err := task.getValidatorSetSizeChange(testPayload(), rule, heightValidatorSequences)
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if count := countSystemEvents(systemEvent); count != tt.expectedCount {
+				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestNetworkSystemEventCreatorTask_getTotalActiveEscrowChange(t *testing.T) {
+	const window = 10
+
+	tests := []struct {
+		description        string
+		currBalance        int64
+		windowStartBalance int64
+		prevBalance        int64
+		prevWindowStartBal int64
+		prevWindowStartErr error
+		expectedCount      int
+		expectedData       string
+	}{
+		{
+			description:        "returns system event when total active escrow started to change by 5 percent within the window",
+			currBalance:        1050,
+			windowStartBalance: 1000,
+			prevBalance:        1040,
+			prevWindowStartBal: 1000,
+			expectedCount:      1,
+			expectedData:       `{"after":"2100","before":"2000","change":-5,"window":10}`,
+		},
+		{
+			description:        "returns no system events when total active escrow already changed by 5 percent at previous height",
+			currBalance:        1060,
+			windowStartBalance: 1000,
+			prevBalance:        1050,
+			prevWindowStartBal: 1000,
+			expectedCount:      0,
+		},
+		{
+			description:        "returns system event when previous window is not indexed",
+			currBalance:        900,
+			windowStartBalance: 1000,
+			prevBalance:        900,
+			prevWindowStartErr: store.ErrNotFound,
+			expectedCount:      1,
+			expectedData:       `{"after":"1800","before":"2000","change":10,"window":10}`,
+		},
+		{
+			description:        "returns no system events when total active escrow change is smaller than 5 percent",
+			currBalance:        1040,
+			windowStartBalance: 1000,
+			expectedCount:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl)
+			blockSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl)
+			stakingSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl)
+
+			validatorSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-window)).Return(newValidatorSeqs(2, tt.windowStartBalance), nil).Times(1)
+			if tt.prevBalance != 0 {
+				validatorSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-1)).Return(newValidatorSeqs(2, tt.prevBalance), nil).Times(1)
+				validatorSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-1-window)).Return(newValidatorSeqs(2, tt.prevWindowStartBal), tt.prevWindowStartErr).Times(1)
+			}
+
+			rule := config.SystemEventRule{Kind: "total_active_escrow_changed", Metric: config.SystemEventMetricTotalActiveEscrowChange, Comparison: config.ComparisonGte, Thresholds: []float64{5}, Window: window}
+			heightValidatorSequences := map[int64][]model.ValidatorSeq{
+				testHeight: newValidatorSeqs(2, tt.currBalance),
+			}
+
+			task := NewNetworkSystemEventCreatorTask(testCfg, []config.SystemEventRule{rule}, validatorSeqStoreMock, blockSeqStoreMock, stakingSeqStoreMock)
+			systemEvent, err := task.getTotalActiveEscrowChange(testPayload(), rule, heightValidatorSequences)
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if count := countSystemEvents(systemEvent); count != tt.expectedCount {
+				t.Fatalf("unexpected system event count, want %v; got %v", tt.expectedCount, count)
+			}
+			if systemEvent != nil && string(systemEvent.Data.RawMessage) != tt.expectedData {
+				t.Errorf("unexpected system event data, want %v; got %v", tt.expectedData, string(systemEvent.Data.RawMessage))
+			}
+		})
+	}
+}
+
+func TestNetworkSystemEventCreatorTask_getAvgBlockTime(t *testing.T) {
+	blockTime := time.Date(2020, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description   string
+		result        *store.GetAvgRecentTimesResult
+		err           error
+		expectedCount int
+		expectedErr   error
+	}{
+		{
+			description:   "returns system event when average block time started to exceed threshold",
+			result:        newAvgRecentTimesResult(blockTime.Add(-100*time.Second), testHeight-1, 10, 9),
+			expectedCount: 1,
+		},
+		{
+			description:   "returns no system events when average block time already exceeded threshold",
+			result:        newAvgRecentTimesResult(blockTime.Add(-120*time.Second), testHeight-1, 10, 11),
+			expectedCount: 0,
+		},
+		{
+			description:   "returns no system events when average block time is below threshold",
+			result:        newAvgRecentTimesResult(blockTime.Add(-60*time.Second), testHeight-1, 10, 5),
+			expectedCount: 0,
+		},
+		{
+			description:   "returns no system events when current height is reindexed",
+			result:        newAvgRecentTimesResult(blockTime.Add(-100*time.Second), testHeight, 10, 9),
+			expectedCount: 0,
+		},
+		{
+			description:   "returns no system events when there are no blocks",
+			result:        &store.GetAvgRecentTimesResult{},
+			expectedCount: 0,
+		},
+		{
+			description: "returns error when query fails",
+			err:         ErrValidatorSeqFindByHeight,
+			expectedErr: ErrValidatorSeqFindByHeight,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl)
+			blockSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl)
+			stakingSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl)
+
+			blockSeqStoreMock.EXPECT().GetAvgRecentTimes(int64(100)).Return(tt.result, tt.err).Times(1)
+
+			rule := config.SystemEventRule{Kind: "block_time_degraded", Metric: config.SystemEventMetricAvgBlockTime, Comparison: config.ComparisonGte, Thresholds: []float64{10}, Window: 100}
+			payload := testPayload()
+			payload.Syncable.Time = *types.NewTimeFromTime(blockTime)
+
+			task := NewNetworkSystemEventCreatorTask(testCfg, []config.SystemEventRule{rule}, validatorSeqStoreMock, blockSeqStoreMock, stakingSeqStoreMock)
+			systemEvent, err := task.getAvgBlockTime(payload, rule)
+			if err != tt.expectedErr {
+				t.Fatalf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+
+			if count := countSystemEvents(systemEvent); count != tt.expectedCount {
+				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestNetworkSystemEventCreatorTask_getCommonPoolChange(t *testing.T) {
+	tests := []struct {
+		description   string
+		prevPool      int64
+		currPool      int64
+		prevErr       error
+		expectedCount int
+		expectedErr   error
+	}{
+		{"returns system event when common pool changed by 1 percent", 1000, 990, nil, 1, nil},
+		{"returns no system events when common pool change is smaller than 1 percent", 1000, 995, nil, 0, nil},
+		{"returns no system events when previous staking sequence is not indexed", 0, 990, store.ErrNotFound, 0, nil},
+		{"returns error when previous staking sequence cannot be found", 0, 990, ErrValidatorSeqFindByHeight, 0, ErrValidatorSeqFindByHeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl)
+			blockSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl)
+			stakingSeqStoreMock := mock_indexer.NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl)
+
+			var prevStakingSeq *model.StakingSeq
+			if tt.prevErr == nil {
+				prevStakingSeq = &model.StakingSeq{CommonPool: types.NewQuantityFromInt64(tt.prevPool)}
+			}
+			stakingSeqStoreMock.EXPECT().FindByHeight(int64(testHeight-1)).Return(prevStakingSeq, tt.prevErr).Times(1)
+
+			rule := config.SystemEventRule{Kind: "common_pool_changed", Metric: config.SystemEventMetricCommonPoolChange, Comparison: config.ComparisonGte, Thresholds: []float64{1}}
+			payload := testPayload()
+			payload.RawStakingState = &statepb.Staking{CommonPool: big.NewInt(tt.currPool).Bytes()}
+
+			task := NewNetworkSystemEventCreatorTask(testCfg, []config.SystemEventRule{rule}, validatorSeqStoreMock, blockSeqStoreMock, stakingSeqStoreMock)
+			systemEvent, err := task.getCommonPoolChange(payload, rule)
+			if err != tt.expectedErr {
+				t.Fatalf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+
+			if count := countSystemEvents(systemEvent); count != tt.expectedCount {
+				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func newValidatorSeqs(count int, balance int64) []model.ValidatorSeq {
+	var validatorSequences []model.ValidatorSeq
+	for i := 0; i < count; i++ {
+		validatorSequences = append(validatorSequences, newValidatorSeq(fmt.Sprintf("address%d", i), balance, 0, true))
+	}
+	return validatorSequences
+}
+
+func newAvgRecentTimesResult(startTime time.Time, endHeight int64, count int64, avg float64) *store.GetAvgRecentTimesResult {
+	return &store.GetAvgRecentTimesResult{
+		StartTime: startTime.Format(time.RFC3339Nano),
+		EndHeight: endHeight,
+		Count:     count,
+		Avg:       avg,
+	}
+}
+
+func countSystemEvents(systemEvent *model.SystemEvent) int {
+	if systemEvent == nil {
+		return 0
+	}
+	return 1
+}
+
+func TestDelegatorSystemEventCreatorTask_getDelegationAddedSystemEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	debondingStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorDebondingStore(ctrl)
+	balanceStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorBalanceStore(ctrl)
+	syncableStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorSyncableStore(ctrl)
+
+	payload := testPayload()
+	payload.CommonPoolAddress = "common_pool"
+	payload.RawEscrowEvents = &eventpb.EscrowEvents{
+		Add: []*eventpb.AddEscrowEvent{
+			{Owner: "delegator1", Escrow: "validator1", Amount: big.NewInt(100).Bytes()},
+			{Owner: "common_pool", Escrow: "validator1", Amount: big.NewInt(10).Bytes()},
+			{Owner: "delegator2", Escrow: "validator1", Amount: big.NewInt(20).Bytes()},
+			{Owner: "delegator1", Escrow: "validator2", Amount: big.NewInt(50).Bytes()},
+		},
+	}
+
+	task := NewDelegatorSystemEventCreatorTask(6, debondingStoreMock, balanceStoreMock, syncableStoreMock)
+	createdSystemEvents, err := task.getDelegationAddedSystemEvents(payload)
+	if err != nil {
+		t.Fatalf("unexpected error, want %v; got %v", nil, err)
+	}
+
+	expectedActors := []string{"delegator1", "delegator2"}
+	expectedData := []string{
+		`{"amount":"150","delegations":[{"amount":"100","validator":"validator1"},{"amount":"50","validator":"validator2"}]}`,
+		`{"amount":"20","delegations":[{"amount":"20","validator":"validator1"}]}`,
+	}
+	if len(createdSystemEvents) != len(expectedData) {
+		t.Fatalf("unexpected system event count, want %v; got %v", len(expectedData), len(createdSystemEvents))
+	}
+
+	for i, systemEvent := range createdSystemEvents {
+		if systemEvent.Kind != model.SystemEventDelegationAdded {
+			t.Errorf("unexpected system event kind, want %v; got %v", model.SystemEventDelegationAdded, systemEvent.Kind)
+		}
+		if systemEvent.Actor != expectedActors[i] {
+			t.Errorf("unexpected system event actor, want %v; got %v", expectedActors[i], systemEvent.Actor)
+		}
+		if string(systemEvent.Data.RawMessage) != expectedData[i] {
+			t.Errorf("unexpected system event data, want %v; got %v", expectedData[i], string(systemEvent.Data.RawMessage))
+		}
+	}
+}
+
+func TestDelegatorSystemEventCreatorTask_getDebondingSystemEvents(t *testing.T) {
+	const startVersion = 6
+
+	tests := []struct {
+		description       string
+		prevIndexVersion  int64
+		prevDebonding     []model.DebondingDelegation
+		currDebonding     []model.DebondingDelegation
+		expectedKinds     []model.SystemEventKind
+		expectedData      []string
+		expectedStarted   int
+		expectedCompleted int
+	}{
+		{
+			description:     "records debonding delegations without system events when previous height is not indexed",
+			currDebonding:   []model.DebondingDelegation{newDebondingDelegation(0, "delegator1", 100, 10)},
+			expectedStarted: 1,
+		},
+		{
+			description:      "records debonding delegations without system events when previous height is indexed by earlier version",
+			prevIndexVersion: startVersion - 1,
+			currDebonding:    []model.DebondingDelegation{newDebondingDelegation(0, "delegator1", 100, 10)},
+			expectedStarted:  1,
+		},
+		{
+			description:      "returns no system events when debonding delegations did not change",
+			prevIndexVersion: startVersion,
+			prevDebonding:    []model.DebondingDelegation{newDebondingDelegation(1, "delegator1", 100, 10)},
+			currDebonding:    []model.DebondingDelegation{newDebondingDelegation(0, "delegator1", 100, 10)},
+		},
+		{
+			description:      "returns reclaim_started system event for first debonding delegation",
+			prevIndexVersion: startVersion,
+			currDebonding:    []model.DebondingDelegation{newDebondingDelegation(0, "delegator1", 40, 12)},
+			expectedKinds:    []model.SystemEventKind{model.SystemEventReclaimStarted},
+			expectedData:     []string{`{"debondings":[{"amount":"20","debond_end":12,"shares":"40","validator":"validator1"}]}`},
+			expectedStarted:  1,
+		},
+		{
+			description:      "returns reclaim_started system event for new debonding delegation",
+			prevIndexVersion: startVersion,
+			prevDebonding:    []model.DebondingDelegation{newDebondingDelegation(1, "delegator1", 100, 10)},
+			currDebonding: []model.DebondingDelegation{
+				newDebondingDelegation(0, "delegator1", 100, 10),
+				newDebondingDelegation(0, "delegator1", 40, 12),
+			},
+			expectedKinds:   []model.SystemEventKind{model.SystemEventReclaimStarted},
+			expectedData:    []string{`{"debondings":[{"amount":"20","debond_end":12,"shares":"40","validator":"validator1"}]}`},
+			expectedStarted: 1,
+		},
+		{
+			description:      "returns debonding_completed system event for debonding delegation which ended",
+			prevIndexVersion: startVersion,
+			prevDebonding: []model.DebondingDelegation{
+				newDebondingDelegation(1, "delegator1", 100, 10),
+				newDebondingDelegation(2, "delegator1", 40, 12),
+			},
+			currDebonding:     []model.DebondingDelegation{newDebondingDelegation(0, "delegator1", 40, 12)},
+			expectedKinds:     []model.SystemEventKind{model.SystemEventDebondingCompleted},
+			expectedData:      []string{`{"debondings":[{"debond_end":10,"shares":"100","validator":"validator1"}]}`},
+			expectedCompleted: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			debondingStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorDebondingStore(ctrl)
+			balanceStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorBalanceStore(ctrl)
+			syncableStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorSyncableStore(ctrl)
+
+			var prevSyncable *model.Syncable
+			if tt.prevIndexVersion > 0 {
+				prevSyncable = &model.Syncable{Height: testHeight - 1, IndexVersion: tt.prevIndexVersion}
+			}
+			if tt.prevIndexVersion >= startVersion {
+				debondingStoreMock.EXPECT().FindActiveAt(int64(testHeight-1)).Return(tt.prevDebonding, nil).Times(1)
+			}
+
+			var opts []testStakingOption
+			for _, d := range tt.currDebonding {
+				opts = append(opts, setDebondingDelegationEntry(d.ValidatorUID, d.DelegatorUID, d.Shares.Int.Bytes(), d.DebondEnd))
+			}
+
+			payload := testPayload()
+			payload.RawStakingState = testpbStaking(opts...)
+			payload.RawStakingState.Ledger = map[string]*accountpb.Account{
+				"validator1": {
+					Escrow: &accountpb.EscrowAccount{
+						Debonding: &accountpb.SharePool{
+							Balance:     big.NewInt(70).Bytes(),
+							TotalShares: big.NewInt(140).Bytes(),
+						},
+					},
+				},
+			}
+
+			task := NewDelegatorSystemEventCreatorTask(startVersion, debondingStoreMock, balanceStoreMock, syncableStoreMock)
+			createdSystemEvents, err := task.getDebondingSystemEvents(payload, prevSyncable)
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if len(createdSystemEvents) != len(tt.expectedKinds) {
+				t.Fatalf("unexpected system event count, want %v; got %v", len(tt.expectedKinds), len(createdSystemEvents))
+			}
+
+			for i, systemEvent := range createdSystemEvents {
+				if systemEvent.Kind != tt.expectedKinds[i] {
+					t.Errorf("unexpected system event kind, want %v; got %v", tt.expectedKinds[i], systemEvent.Kind)
+				}
+				if systemEvent.Actor != "delegator1" {
+					t.Errorf("unexpected system event actor, want %v; got %v", "delegator1", systemEvent.Actor)
+				}
+				if string(systemEvent.Data.RawMessage) != tt.expectedData[i] {
+					t.Errorf("unexpected system event data, want %v; got %v", tt.expectedData[i], string(systemEvent.Data.RawMessage))
+				}
+			}
+
+			var started, completed int
+			for _, d := range payload.DebondingDelegations {
+				if d.EndHeight == nil {
+					if d.StartHeight != testHeight {
+						t.Errorf("unexpected start height of started debonding delegation, want %v; got %v", testHeight, d.StartHeight)
+					}
+					started++
+					continue
+				}
+
+				if *d.EndHeight != testHeight {
+					t.Errorf("unexpected end height of completed debonding delegation, want %v; got %v", testHeight, *d.EndHeight)
+				}
+				if d.ID == 0 {
+					t.Errorf("completed debonding delegation should keep its id")
+				}
+				completed++
+			}
+			if started != tt.expectedStarted {
+				t.Errorf("unexpected started debonding delegations count, want %v; got %v", tt.expectedStarted, started)
+			}
+			if completed != tt.expectedCompleted {
+				t.Errorf("unexpected completed debonding delegations count, want %v; got %v", tt.expectedCompleted, completed)
+			}
+		})
+	}
+}
+
+func TestDelegatorSystemEventCreatorTask_getDailyRewardSystemEvents(t *testing.T) {
+	currTime := time.Date(2020, 6, 2, 0, 0, 5, 0, time.UTC)
+
+	tests := []struct {
+		description    string
+		prevTime       time.Time
+		expectedEvents int
+	}{
+		{"returns no system events within the same day", time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC), 0},
+		{"returns system events at first height of a day", time.Date(2020, 6, 1, 23, 59, 55, 0, time.UTC), 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			debondingStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorDebondingStore(ctrl)
+			balanceStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorBalanceStore(ctrl)
+			syncableStoreMock := mock_indexer.NewMockDelegatorSystemEventCreatorSyncableStore(ctrl)
+
+			if tt.expectedEvents > 0 {
+				balanceStoreMock.EXPECT().FindRewardTotals(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC)).Return([]store.RewardTotalRow{
+					{Address: "delegator1", Amount: types.NewQuantityFromInt64(25)},
+					{Address: "delegator2", Amount: types.NewQuantityFromInt64(0)},
+				}, nil).Times(1)
+			}
+
+			payload := testPayload()
+			payload.Syncable.Time = *types.NewTimeFromTime(currTime)
+			prevSyncable := &model.Syncable{Height: testHeight - 1, Time: *types.NewTimeFromTime(tt.prevTime)}
+
+			task := NewDelegatorSystemEventCreatorTask(6, debondingStoreMock, balanceStoreMock, syncableStoreMock)
+			createdSystemEvents, err := task.getDailyRewardSystemEvents(payload, prevSyncable)
+			if err != nil {
+				t.Fatalf("unexpected error, want %v; got %v", nil, err)
+			}
+
+			if len(createdSystemEvents) != tt.expectedEvents {
+				t.Fatalf("unexpected system event count, want %v; got %v", tt.expectedEvents, len(createdSystemEvents))
+			}
+
+			for _, systemEvent := range createdSystemEvents {
+				if systemEvent.Kind != model.SystemEventDailyReward {
+					t.Errorf("unexpected system event kind, want %v; got %v", model.SystemEventDailyReward, systemEvent.Kind)
+				}
+				if systemEvent.Actor != "delegator1" {
+					t.Errorf("unexpected system event actor, want %v; got %v", "delegator1", systemEvent.Actor)
+				}
+				expectedData := `{"amount":"25","date":"2020-06-01"}`
+				if string(systemEvent.Data.RawMessage) != expectedData {
+					t.Errorf("unexpected system event data, want %v; got %v", expectedData, string(systemEvent.Data.RawMessage))
+				}
+			}
+		})
+	}
+}
+
+func newDebondingDelegation(id types.ID, delegator string, shares int64, debondEnd uint64) model.DebondingDelegation {
+	return model.DebondingDelegation{
+		Model:        &model.Model{ID: id},
+		ValidatorUID: "validator1",
+		DelegatorUID: delegator,
+		Shares:       types.NewQuantityFromInt64(shares),
+		DebondEnd:    debondEnd,
+		StartHeight:  testHeight - 5,
+	}
+}
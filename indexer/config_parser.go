@@ -16,6 +16,7 @@ const (
 	IndexTargetSystemEvents
 	IndexValidatorRewards
 	IndexBalanceEvents
+	IndexDelegatorSystemEvents
 )
 
 var (
@@ -24,6 +25,7 @@ var (
 
 type ConfigParser interface {
 	GetCurrentVersionId() int64
+	GetFirstVersionIdByTargetId(targetId int64) (int64, error)
 	GetAllVersionedVersionIds() []int64
 	IsAnyVersionSequential(versionIds []int64) bool
 	GetAllAvailableTasks() []pipeline.TaskName
@@ -92,6 +94,18 @@ func (o *configParser) GetCurrentVersionId() int64 {
 	return lastVersion.ID
 }
 
+// GetFirstVersionIdByTargetId gets id of the first version which indexes given target
+func (o *configParser) GetFirstVersionIdByTargetId(targetId int64) (int64, error) {
+	for _, v := range o.targets.Versions {
+		for _, t := range v.Targets {
+			if t == targetId {
+				return v.ID, nil
+			}
+		}
+	}
+	return 0, errors.New(fmt.Sprintf("target id %d is not in any version", targetId))
+}
+
 // GetAllAvailableTasks get lists of tasks for all available targets
 func (o *configParser) GetAllAvailableTasks() []pipeline.TaskName {
 	var allAvailableTaskNames []pipeline.TaskName
@@ -346,6 +346,50 @@ func TestConfigParser_GetCurrentVersion(t *testing.T) {
 	})
 }
 
+func TestConfigParser_GetFirstVersionIdByTargetId(t *testing.T) {
+	fileName := "test_indexer_config.json"
+	var targetsJsonBlob = []byte(`{"versions": [{"id": 1, "targets": [1, 2]}, {"id": 2, "targets": [3]}, {"id": 3, "targets": [2, 3]}]}`)
+
+	tests := []struct {
+		description string
+		targetId    int64
+		expected    int64
+		expectErr   bool
+	}{
+		{description: "returns version which added target", targetId: 3, expected: 2},
+		{description: "returns first of many versions", targetId: 2, expected: 1},
+		{description: "returns error when target is not in any version", targetId: 4, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			test.CreateFile(t, fileName, targetsJsonBlob)
+			defer test.CleanUp(t, fileName)
+
+			parser, err := NewConfigParser(fileName)
+			if err != nil {
+				t.Errorf("NewConfigParser should not return error: err=%+v", err)
+				return
+			}
+
+			got, err := parser.GetFirstVersionIdByTargetId(tt.targetId)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("should not return error: err=%+v", err)
+				return
+			}
+			if got != tt.expected {
+				t.Errorf("unexpected version, want: %d; got: %d", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestConfigParser_GetAllVersionedVersionIds(t *testing.T) {
 	fileName := "test_indexer_config.json"
 	var targetsJsonBlob = []byte(`
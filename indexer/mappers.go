@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"sort"
+
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
@@ -51,6 +53,7 @@ func ValidatorToSequence(syncable *model.Syncable, rawValidators []*validatorpb.
 			e.PrecommitValidated = parsedValidator.PrecommitValidated
 			e.Proposed = parsedValidator.Proposed
 			e.TotalShares = parsedValidator.TotalShares
+			e.Delegators = parsedValidator.Delegators
 			e.ActiveEscrowBalance = parsedValidator.ActiveEscrowBalance
 			e.Rewards = parsedValidator.Rewards
 		}
@@ -136,6 +139,42 @@ func DelegationToSequence(syncable *model.Syncable, rawState *statepb.State) ([]
 	return delegations, nil
 }
 
+// StakingStateToDebondingDelegations returns debonding delegations of staking state started at given height,
+// sorted by validator, delegator and debond end
+func StakingStateToDebondingDelegations(height int64, rawStakingState *statepb.Staking) ([]model.DebondingDelegation, error) {
+	var delegations []model.DebondingDelegation
+	for validatorUID, delegationsMap := range rawStakingState.GetDebondingDelegations() {
+		for delegatorUID, infoArray := range delegationsMap.GetEntries() {
+			for _, delegation := range infoArray.GetDebondingDelegations() {
+				d := model.DebondingDelegation{
+					ValidatorUID: validatorUID,
+					DelegatorUID: delegatorUID,
+					Shares:       types.NewQuantityFromBytes(delegation.GetShares()),
+					DebondEnd:    delegation.GetDebondEndTime(),
+					StartHeight:  height,
+				}
+
+				if !d.Valid() {
+					return nil, errors.New("debonding delegation not valid")
+				}
+
+				delegations = append(delegations, d)
+			}
+		}
+	}
+
+	sort.SliceStable(delegations, func(i, j int) bool {
+		if delegations[i].ValidatorUID != delegations[j].ValidatorUID {
+			return delegations[i].ValidatorUID < delegations[j].ValidatorUID
+		}
+		if delegations[i].DelegatorUID != delegations[j].DelegatorUID {
+			return delegations[i].DelegatorUID < delegations[j].DelegatorUID
+		}
+		return delegations[i].DebondEnd < delegations[j].DebondEnd
+	})
+	return delegations, nil
+}
+
 func DebondingDelegationToSequence(syncable *model.Syncable, rawState *statepb.State) ([]model.DebondingDelegationSeq, error) {
 	var delegations []model.DebondingDelegationSeq
 	for validatorUID, delegationsMap := range rawState.GetStaking().GetDebondingDelegations() {
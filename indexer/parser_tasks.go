@@ -92,6 +92,7 @@ type parsedValidator struct {
 	PrecommitBlockIdFlag int64
 	PrecommitIndex       int64
 	TotalShares          types.Quantity
+	Delegators           int64
 	ActiveEscrowBalance  types.Quantity
 	Rewards              types.Quantity
 }
@@ -158,7 +159,7 @@ func (t *validatorsParserTask) Run(ctx context.Context, p pipeline.Payload) erro
 		// Get proposed
 		calculatedData.Proposed = fetchedBlock.GetHeader().GetProposerAddress() == tendermintAddress
 
-		// Get total shares
+		// Get total shares and number of delegators
 		delegations, ok := fetchedStakingState.GetDelegations()[address]
 		totalShares := big.NewInt(0)
 		if ok {
@@ -166,6 +167,7 @@ func (t *validatorsParserTask) Run(ctx context.Context, p pipeline.Payload) erro
 				shares := types.NewQuantityFromBytes(d.Shares)
 				totalShares = totalShares.Add(totalShares, &shares.Int)
 			}
+			calculatedData.Delegators = int64(len(delegations.Entries))
 		}
 		calculatedData.TotalShares = types.NewQuantity(totalShares)
 
@@ -196,6 +198,15 @@ type balanceParserTask struct {
 	metricObserver metrics.Observer
 }
 
+// ParsedSlash holds amount taken from escrow pool of validator
+type ParsedSlash struct {
+	EscrowAddress string
+	Pool          string
+	Amount        types.Quantity
+	// Balance is balance of the pool after amount was taken
+	Balance types.Quantity
+}
+
 func (t *balanceParserTask) GetName() string {
 	return TaskNameBalanceParser
 }
@@ -219,6 +230,7 @@ func (t *balanceParserTask) Run(ctx context.Context, p pipeline.Payload) error {
 	}
 
 	balanceEvents := []model.BalanceEvent{}
+	var parsedSlashes []ParsedSlash
 	for _, fetchedValidator := range fetchedValidators {
 		escrowAddr := fetchedValidator.GetAddress()
 
@@ -244,10 +256,17 @@ func (t *balanceParserTask) Run(ctx context.Context, p pipeline.Payload) error {
 				return err
 			}
 			balanceEvents = append(balanceEvents, events...)
+
+			slashes, err := getParsedSlashes(escrowAddr, escrowAccount, amount)
+			if err != nil {
+				return err
+			}
+			parsedSlashes = append(parsedSlashes, slashes...)
 		}
 	}
 
 	payload.BalanceEvents = balanceEvents
+	payload.ParsedSlashes = parsedSlashes
 	return nil
 }
 
@@ -387,24 +406,11 @@ func createRewardAndCommissionBalanceEvents(escrowAddr string, account *accountp
 }
 
 func createSlashBalanceEvents(escrowAddr string, account *accountpb.EscrowAccount, stakingState *statepb.Staking, amount types.Quantity, height int64) ([]model.BalanceEvent, error) {
-	var err error
 	balanceEvents := []model.BalanceEvent{}
 
-	currActiveBalance := types.NewQuantityFromBytes(account.GetActive().GetBalance())
-	currDebondingBalance := types.NewQuantityFromBytes(account.GetDebonding().GetBalance())
-
-	total := currActiveBalance.Clone()
-	if err = total.Add(currDebondingBalance); err != nil {
-		return nil, fmt.Errorf("compute total balance: %w", err)
-	}
-
-	// amount slashed is split between the debonding and active pools based on relative total balance.
-	totalSlashedActive := currActiveBalance.Clone()
-	if err := totalSlashedActive.Mul(amount); err != nil {
-		return nil, fmt.Errorf("totalSlashedActive.Mul: %v", err)
-	}
-	if err := totalSlashedActive.Quo(total); err != nil {
-		return nil, fmt.Errorf("totalSlashedActive.Quo: %v", err)
+	totalSlashedActive, totalSlashedDebonding, err := splitSlashed(account, amount)
+	if err != nil {
+		return nil, err
 	}
 
 	totalActiveShares := types.NewQuantityFromBytes(account.GetActive().GetTotalShares())
@@ -434,14 +440,6 @@ func createSlashBalanceEvents(escrowAddr string, account *accountpb.EscrowAccoun
 		}
 	}
 
-	totalSlashedDebonding := currDebondingBalance.Clone()
-	if err := totalSlashedDebonding.Mul(amount); err != nil {
-		return nil, fmt.Errorf("totalSlashedDebonding.Mul: %v", err)
-	}
-	if err := totalSlashedDebonding.Quo(total); err != nil {
-		return nil, fmt.Errorf("totalSlashedDebonding.Quo: %v", err)
-	}
-
 	totalDebondingShares := types.NewQuantityFromBytes(account.GetDebonding().GetTotalShares())
 
 	debondingDelegations, ok := stakingState.GetDebondingDelegations()[escrowAddr]
@@ -485,3 +483,62 @@ func createSlashBalanceEvents(escrowAddr string, account *accountpb.EscrowAccoun
 	}
 	return balanceEvents, nil
 }
+
+// splitSlashed splits amount slashed between the active and debonding pools based on relative total balance
+func splitSlashed(account *accountpb.EscrowAccount, amount types.Quantity) (active types.Quantity, debonding types.Quantity, err error) {
+	currActiveBalance := types.NewQuantityFromBytes(account.GetActive().GetBalance())
+	currDebondingBalance := types.NewQuantityFromBytes(account.GetDebonding().GetBalance())
+
+	total := currActiveBalance.Clone()
+	if err = total.Add(currDebondingBalance); err != nil {
+		return active, debonding, fmt.Errorf("compute total balance: %w", err)
+	}
+
+	active = currActiveBalance.Clone()
+	if err = active.Mul(amount); err != nil {
+		return active, debonding, fmt.Errorf("totalSlashedActive.Mul: %v", err)
+	}
+	if err = active.Quo(total); err != nil {
+		return active, debonding, fmt.Errorf("totalSlashedActive.Quo: %v", err)
+	}
+
+	debonding = currDebondingBalance.Clone()
+	if err = debonding.Mul(amount); err != nil {
+		return active, debonding, fmt.Errorf("totalSlashedDebonding.Mul: %v", err)
+	}
+	if err = debonding.Quo(total); err != nil {
+		return active, debonding, fmt.Errorf("totalSlashedDebonding.Quo: %v", err)
+	}
+
+	return active, debonding, nil
+}
+
+// getParsedSlashes returns amounts taken from escrow pools of validator
+func getParsedSlashes(escrowAddr string, account *accountpb.EscrowAccount, amount types.Quantity) ([]ParsedSlash, error) {
+	slashedActive, slashedDebonding, err := splitSlashed(account, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedSlashes []ParsedSlash
+	for _, pool := range []struct {
+		name      string
+		slashed   types.Quantity
+		sharePool *accountpb.SharePool
+	}{
+		{name: model.EscrowPoolActive, slashed: slashedActive, sharePool: account.GetActive()},
+		{name: model.EscrowPoolDebonding, slashed: slashedDebonding, sharePool: account.GetDebonding()},
+	} {
+		if pool.slashed.IsZero() {
+			continue
+		}
+
+		parsedSlashes = append(parsedSlashes, ParsedSlash{
+			EscrowAddress: escrowAddr,
+			Pool:          pool.name,
+			Amount:        pool.slashed,
+			Balance:       types.NewQuantityFromBytes(pool.sharePool.GetBalance()),
+		})
+	}
+	return parsedSlashes, nil
+}
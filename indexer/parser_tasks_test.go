@@ -140,7 +140,7 @@ func TestValidatorParserTask_Run(t *testing.T) {
 				},
 			},
 		},
-		{description: "updates total shares",
+		{description: "updates total shares and delegators",
 			rawBlock: testpbBlock(
 				setBlockLastCommitVotes(),
 				setBlockProposerAddress(proposerAddr),
@@ -166,6 +166,7 @@ func TestValidatorParserTask_Run(t *testing.T) {
 					PrecommitBlockIdFlag: 3,
 					PrecommitIndex:       0,
 					TotalShares:          types.NewQuantityFromInt64(200),
+					Delegators:           2,
 				},
 				"t1": parsedValidator{
 					Proposed:             false,
@@ -173,6 +174,7 @@ func TestValidatorParserTask_Run(t *testing.T) {
 					PrecommitBlockIdFlag: 3,
 					PrecommitIndex:       1,
 					TotalShares:          types.NewQuantityFromInt64(100),
+					Delegators:           1,
 				},
 			},
 		},
@@ -635,6 +637,13 @@ func TestBalanceParserTask_Run(t *testing.T) {
 				t.Errorf("Unexpected event, want: %+v, got: %+v", expected, event)
 			}
 		}
+
+		expectedSlashes := []ParsedSlash{
+			{EscrowAddress: escrowAddr, Pool: model.EscrowPoolActive, Amount: types.NewQuantityFromInt64(50), Balance: types.NewQuantityFromInt64(350)},
+		}
+		if !reflect.DeepEqual(pld.ParsedSlashes, expectedSlashes) {
+			t.Errorf("Unexpected ParsedSlashes, want: %+v, got: %+v", expectedSlashes, pld.ParsedSlashes)
+		}
 	})
 
 	t.Run("creates slash debonding events", func(t *testing.T) {
@@ -726,6 +735,13 @@ func TestBalanceParserTask_Run(t *testing.T) {
 				t.Errorf("Unexpected event, want: %+v, got: %+v", expected, event)
 			}
 		}
+
+		expectedSlashes := []ParsedSlash{
+			{EscrowAddress: escrowAddr, Pool: model.EscrowPoolDebonding, Amount: types.NewQuantityFromInt64(500), Balance: types.NewQuantityFromInt64(3500)},
+		}
+		if !reflect.DeepEqual(pld.ParsedSlashes, expectedSlashes) {
+			t.Errorf("Unexpected ParsedSlashes, want: %+v, got: %+v", expectedSlashes, pld.ParsedSlashes)
+		}
 	})
 
 }
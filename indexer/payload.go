@@ -55,12 +55,15 @@ type payload struct {
 	ParsedBlock      ParsedBlockData
 	ParsedValidators ParsedValidatorsData
 	BalanceEvents    []model.BalanceEvent
+	ParsedSlashes    []ParsedSlash
 
 	// Aggregator stage
 	NewAggregatedAccounts       []model.AccountAgg
 	UpdatedAggregatedAccounts   []model.AccountAgg
 	NewAggregatedValidators     []model.ValidatorAgg
 	UpdatedAggregatedValidators []model.ValidatorAgg
+	// PreviousAggregatedValidators holds stored state of updated validators
+	PreviousAggregatedValidators []model.ValidatorAgg
 
 	// Sequencer stage
 	NewBlockSequence          *model.BlockSeq
@@ -74,7 +77,9 @@ type payload struct {
 	DebondingDelegationSequences []model.DebondingDelegationSeq
 
 	// Analyzer
-	SystemEvents []*model.SystemEvent
+	SystemEvents         []*model.SystemEvent
+	ValidatorUptimes     []model.ValidatorUptime
+	DebondingDelegations []model.DebondingDelegation
 }
 
 func (p *payload) MarkAsProcessed() {}
@@ -11,12 +11,14 @@ import (
 )
 
 const (
-	TaskNameBalanceEventPersistor = "BalanceEventPersistor"
-	TaskNameSyncerPersistor       = "SyncerPersistor"
-	TaskNameBlockSeqPersistor     = "BlockSeqPersistor"
-	TaskNameValidatorSeqPersistor = "ValidatorSeqPersistor"
-	TaskNameValidatorAggPersistor = "ValidatorAggPersistor"
-	TaskNameSystemEventPersistor  = "SystemEventPersistor"
+	TaskNameBalanceEventPersistor        = "BalanceEventPersistor"
+	TaskNameDebondingDelegationPersistor = "DebondingDelegationPersistor"
+	TaskNameSyncerPersistor              = "SyncerPersistor"
+	TaskNameBlockSeqPersistor            = "BlockSeqPersistor"
+	TaskNameValidatorSeqPersistor        = "ValidatorSeqPersistor"
+	TaskNameValidatorAggPersistor        = "ValidatorAggPersistor"
+	TaskNameSystemEventPersistor         = "SystemEventPersistor"
+	TaskNameValidatorUptimePersistor     = "ValidatorUptimePersistor"
 )
 
 func NewSyncerPersistorTask(db SyncerPersistorTaskStore) pipeline.Task {
@@ -251,3 +253,99 @@ func (t *balanceEventPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 
 	return nil
 }
+
+func NewValidatorUptimePersistorTask(db ValidatorUptimePersistorTaskStore) pipeline.Task {
+	return &validatorUptimePersistorTask{
+		db:             db,
+		metricObserver: indexerTaskDuration.WithLabels(TaskNameValidatorUptimePersistor),
+	}
+}
+
+type ValidatorUptimePersistorTaskStore interface {
+	Create(record interface{}) error
+	Save(record interface{}) error
+}
+
+type validatorUptimePersistorTask struct {
+	db             ValidatorUptimePersistorTaskStore
+	metricObserver metrics.Observer
+}
+
+func (t *validatorUptimePersistorTask) GetName() string {
+	return TaskNameValidatorUptimePersistor
+}
+
+func (t *validatorUptimePersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	timer := metrics.NewTimer(t.metricObserver)
+	defer timer.ObserveDuration()
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, uptime := range payload.ValidatorUptimes {
+		if uptime.Model == nil || uptime.ID == 0 {
+			if err := t.db.Create(&uptime); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := t.db.Save(&uptime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewDebondingDelegationPersistorTask(db DebondingDelegationPersistorTaskStore) pipeline.Task {
+	return &debondingDelegationPersistorTask{
+		db:             db,
+		metricObserver: indexerTaskDuration.WithLabels(TaskNameDebondingDelegationPersistor),
+	}
+}
+
+type DebondingDelegationPersistorTaskStore interface {
+	Create(record interface{}) error
+	Save(record interface{}) error
+	Revert(int64) error
+}
+
+type debondingDelegationPersistorTask struct {
+	db             DebondingDelegationPersistorTaskStore
+	metricObserver metrics.Observer
+}
+
+func (t *debondingDelegationPersistorTask) GetName() string {
+	return TaskNameDebondingDelegationPersistor
+}
+
+func (t *debondingDelegationPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+	timer := metrics.NewTimer(t.metricObserver)
+	defer timer.ObserveDuration()
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	// Changes made by previous run of the same height are replaced
+	if err := t.db.Revert(payload.CurrentHeight); err != nil {
+		return err
+	}
+
+	for _, debondingDelegation := range payload.DebondingDelegations {
+		if debondingDelegation.Model == nil || debondingDelegation.ID == 0 {
+			if err := t.db.Create(&debondingDelegation); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := t.db.Save(&debondingDelegation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -104,6 +104,64 @@ func TestBalanceEventPersistor_Run(t *testing.T) {
 	}
 }
 
+func TestDebondingDelegationPersistor_Run(t *testing.T) {
+	endHeight := int64(20)
+	started := model.DebondingDelegation{
+		ValidatorUID: "escrowAddr",
+		DelegatorUID: "delegatorAddr1",
+		Shares:       types.NewQuantityFromInt64(80),
+		DebondEnd:    12,
+		StartHeight:  20,
+	}
+	completed := model.DebondingDelegation{
+		Model:        &model.Model{ID: 1},
+		ValidatorUID: "escrowAddr",
+		DelegatorUID: "delegatorAddr2",
+		Shares:       types.NewQuantityFromInt64(100),
+		DebondEnd:    10,
+		StartHeight:  5,
+		EndHeight:    &endHeight,
+	}
+
+	tests := []struct {
+		description string
+		revertErr   error
+		expectErr   error
+	}{
+		{"reverts height and persists changes", nil, nil},
+		{"returns error if revert errors", errTestDbCreate, errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockDebondingDelegationPersistorTaskStore(ctrl)
+
+			task := NewDebondingDelegationPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:        20,
+				DebondingDelegations: []model.DebondingDelegation{started, completed},
+			}
+
+			revertCall := dbMock.EXPECT().Revert(int64(20)).Return(tt.revertErr).Times(1)
+			if tt.revertErr == nil {
+				gomock.InOrder(
+					revertCall,
+					dbMock.EXPECT().Create(&started).Return(nil).Times(1),
+					dbMock.EXPECT().Save(&completed).Return(nil).Times(1),
+				)
+			}
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestBlockSeqPersistor_Run(t *testing.T) {
 	seq := &model.BlockSeq{
 		Sequence: &model.Sequence{
@@ -95,8 +95,25 @@ func NewPipeline(cfg *config.Config, db *store.Store, client *client.Client) (*i
 		pipeline.RetryingTask(NewValidatorAggCreatorTask(db.ValidatorAgg), isTransient, 3),
 	)
 
+	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add analyzer stage
-	defaultPipeline.AddStageBefore(pipeline.StagePersistor, pipeline.NewStageWithTasks(StageAnalyzer, NewSystemEventCreatorTask(cfg, db.ValidatorSeq)))
+	systemEventRules, err := cfg.GetSystemEventRules()
+	if err != nil {
+		return nil, err
+	}
+	delegatorSystemEventsVersion, err := configParser.GetFirstVersionIdByTargetId(IndexDelegatorSystemEvents)
+	if err != nil {
+		return nil, err
+	}
+	defaultPipeline.AddStageBefore(pipeline.StagePersistor, pipeline.NewStageWithTasks(StageAnalyzer,
+		NewSystemEventCreatorTask(cfg, systemEventRules, db.ValidatorSeq, db.ValidatorUptimes),
+		NewNetworkSystemEventCreatorTask(cfg, systemEventRules, db.ValidatorSeq, db.BlockSeq, db.StakingSeq),
+		NewDelegatorSystemEventCreatorTask(delegatorSystemEventsVersion, db.DebondingDelegations, db.BalanceEvents, db.Syncables),
+	))
 
 	// Set persistor stage
 	defaultPipeline.SetAsyncTasks(
@@ -106,14 +123,11 @@ func NewPipeline(cfg *config.Config, db *store.Store, client *client.Client) (*i
 		pipeline.RetryingTask(NewValidatorSeqPersistorTask(db.ValidatorSeq), isTransient, 3),
 		pipeline.RetryingTask(NewValidatorAggPersistorTask(db.ValidatorAgg), isTransient, 3),
 		pipeline.RetryingTask(NewSystemEventPersistorTask(db.SystemEvents), isTransient, 3),
+		pipeline.RetryingTask(NewValidatorUptimePersistorTask(db.ValidatorUptimes), isTransient, 3),
 		pipeline.RetryingTask(NewBalanceEventPersistorTask(db.BalanceEvents), isTransient, 3),
+		pipeline.RetryingTask(NewDebondingDelegationPersistorTask(db.DebondingDelegations), isTransient, 3),
 	)
 
-	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
-	if err != nil {
-		return nil, err
-	}
-
 	statusChecker := pipelineStatusChecker{db.Syncables, configParser.GetCurrentVersionId()}
 	pipelineStatus, err := statusChecker.getStatus()
 	if err != nil {
@@ -184,6 +198,11 @@ func (o *indexingPipeline) Index(ctx context.Context, indexCfg IndexConfig) erro
 
 	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
 
+	// Summaries could have been computed from records which were replaced during backfill
+	if err := o.db.SummaryWatermarks.Rewind(currentIndexVersion, source.startHeight-1); err != nil {
+		return err
+	}
+
 	err = reportCreator.complete(source.Len(), sink.successCount, err)
 
 	return nil
@@ -266,6 +285,11 @@ func (o *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 
 	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
 
+	// Summaries could have been computed from records which were replaced during backfill
+	if err := o.db.SummaryWatermarks.Rewind(currentIndexVersion, source.startHeight-1); err != nil {
+		return err
+	}
+
 	err = reportCreator.complete(source.Len(), sink.successCount, err)
 
 	return nil
@@ -320,6 +344,12 @@ func (o *indexingPipeline) Run(ctx context.Context, runCfg RunConfig) (*payload,
 
 	logger.Info("pipeline completed successfully")
 
+	if !runCfg.Dry {
+		if err := o.db.SummaryWatermarks.Rewind(o.configParser.GetCurrentVersionId(), runCfg.Height-1); err != nil {
+			return nil, err
+		}
+	}
+
 	payload := runPayload.(*payload)
 	return payload, nil
 }
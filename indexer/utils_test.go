@@ -224,6 +224,7 @@ func testpbValidator(opts ...testValidatorOption) *validatorpb.Validator {
 		TendermintAddress: randString(5),
 		VotingPower:       64,
 		Node: &validatorpb.Node{
+			Id:       randString(5),
 			EntityId: randString(5),
 		},
 	}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS summary_watermarks
+(
+    id            BIGSERIAL                NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    source        TEXT                     NOT NULL,
+    index_version INT                      NOT NULL,
+    height        DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE index idx_summary_watermarks_source on summary_watermarks (source, index_version);
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id                   BIGSERIAL                NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    url                  TEXT                     NOT NULL,
+    secret               TEXT                     NOT NULL,
+    actor                TEXT,
+    kind                 VARCHAR(100),
+    last_system_event_id BIGINT                   NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              BIGSERIAL                NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    subscription_id BIGINT                   NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    system_event_id BIGINT                   NOT NULL,
+    status          VARCHAR(20)              NOT NULL,
+    attempts        INT                      NOT NULL DEFAULT 0,
+    response_code   INT,
+    error_msg       TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE index idx_webhook_deliveries_subscription_event on webhook_deliveries (subscription_id, system_event_id);
+CREATE index idx_webhook_deliveries_pending on webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS syncable_processed ON syncables;
+DROP FUNCTION IF EXISTS notify_syncable_processed();
@@ -0,0 +1,12 @@
+CREATE OR REPLACE FUNCTION notify_syncable_processed() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('syncable_processed', NEW.height::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER syncable_processed
+    AFTER INSERT OR UPDATE ON syncables
+    FOR EACH ROW
+    WHEN (NEW.processed_at IS NOT NULL)
+EXECUTE PROCEDURE notify_syncable_processed();
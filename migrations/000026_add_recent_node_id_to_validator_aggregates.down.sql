@@ -0,0 +1 @@
+ALTER TABLE validator_aggregates DROP COLUMN recent_node_id;
@@ -0,0 +1 @@
+ALTER TABLE validator_aggregates ADD COLUMN recent_node_id TEXT;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS validator_uptimes
+(
+    id            BIGSERIAL                NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    address       TEXT                     NOT NULL,
+    height        DECIMAL(65, 0)           NOT NULL,
+    size          INTEGER                  NOT NULL,
+    count         INTEGER                  NOT NULL,
+    position      INTEGER                  NOT NULL,
+    missed        BYTEA                    NOT NULL,
+    missed_in_row DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_validator_uptimes_address on validator_uptimes (address);
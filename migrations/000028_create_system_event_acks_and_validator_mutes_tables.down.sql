@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS system_event_acks;
+DROP TABLE IF EXISTS validator_mutes;
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS system_event_acks
+(
+    id              BIGSERIAL                NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    system_event_id BIGINT                   NOT NULL REFERENCES system_events (id) ON DELETE CASCADE,
+    subscriber      TEXT                     NOT NULL,
+    note            TEXT,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS validator_mutes
+(
+    id         BIGSERIAL                NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    address    TEXT                     NOT NULL,
+    subscriber TEXT                     NOT NULL,
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time   TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason     TEXT,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_system_event_acks_event_subscriber on system_event_acks (system_event_id, subscriber);
+CREATE INDEX idx_validator_mutes_subscriber_address on validator_mutes (subscriber, address, end_time);
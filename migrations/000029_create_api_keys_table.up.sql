@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id         BIGSERIAL                NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    name       TEXT                     NOT NULL,
+    key_hash   TEXT                     NOT NULL,
+    scopes     TEXT[]                   NOT NULL,
+    rate_limit BIGINT,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX idx_api_keys_name on api_keys (name);
+CREATE UNIQUE INDEX idx_api_keys_key_hash on api_keys (key_hash);
@@ -0,0 +1,3 @@
+ALTER TABLE validator_summary DROP COLUMN share_price_avg;
+ALTER TABLE validator_summary DROP COLUMN share_price_min;
+ALTER TABLE validator_summary DROP COLUMN share_price_max;
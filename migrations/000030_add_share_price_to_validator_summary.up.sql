@@ -0,0 +1,3 @@
+ALTER TABLE validator_summary ADD COLUMN share_price_avg DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE validator_summary ADD COLUMN share_price_min DECIMAL NOT NULL DEFAULT 0;
+ALTER TABLE validator_summary ADD COLUMN share_price_max DECIMAL NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE reports DROP COLUMN skipped_count;
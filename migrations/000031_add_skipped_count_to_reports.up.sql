@@ -0,0 +1 @@
+ALTER TABLE reports ADD COLUMN skipped_count INT;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_webhook_subscriptions_api_key_id;
+ALTER TABLE webhook_subscriptions DROP COLUMN api_key_id;
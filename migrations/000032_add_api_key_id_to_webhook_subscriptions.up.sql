@@ -0,0 +1,4 @@
+-- Subscriptions created before have no owner, they keep receiving deliveries but are not accessible through API
+ALTER TABLE webhook_subscriptions ADD COLUMN api_key_id BIGINT REFERENCES api_keys (id);
+
+CREATE index idx_webhook_subscriptions_api_key_id on webhook_subscriptions (api_key_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_system_events_created_at;
+
+ALTER TABLE webhook_subscriptions ADD COLUMN last_system_event_id BIGINT NOT NULL DEFAULT 0;
+
+UPDATE webhook_subscriptions
+SET last_system_event_id = COALESCE((SELECT MAX(system_event_id) FROM webhook_deliveries WHERE subscription_id = webhook_subscriptions.id), 0);
+
+ALTER TABLE webhook_subscriptions DROP COLUMN queued_until;
@@ -0,0 +1,9 @@
+ALTER TABLE webhook_subscriptions ADD COLUMN queued_until TIMESTAMP WITH TIME ZONE;
+
+UPDATE webhook_subscriptions
+SET queued_until = COALESCE((SELECT created_at FROM system_events WHERE id = webhook_subscriptions.last_system_event_id), created_at);
+
+ALTER TABLE webhook_subscriptions ALTER COLUMN queued_until SET NOT NULL;
+ALTER TABLE webhook_subscriptions DROP COLUMN last_system_event_id;
+
+CREATE index idx_system_events_created_at on system_events (created_at);
@@ -0,0 +1 @@
+CREATE index idx_syncables_time on syncables (time);
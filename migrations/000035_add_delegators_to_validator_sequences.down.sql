@@ -0,0 +1 @@
+ALTER TABLE validator_sequences DROP COLUMN delegators;
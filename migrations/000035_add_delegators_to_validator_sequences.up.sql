@@ -0,0 +1 @@
+ALTER TABLE validator_sequences ADD COLUMN delegators INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS debonding_delegations;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS debonding_delegations
+(
+    id            BIGSERIAL                NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    validator_uid TEXT                     NOT NULL,
+    delegator_uid TEXT                     NOT NULL,
+    shares        DECIMAL(65, 0)           NOT NULL,
+    debond_end    BIGINT                   NOT NULL,
+
+    start_height  BIGINT                   NOT NULL,
+    end_height    BIGINT,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_debonding_delegations_start_height on debonding_delegations (start_height);
+CREATE index idx_debonding_delegations_end_height on debonding_delegations (end_height);
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/oasishub-indexer/indexer (interfaces: AccountAggCreatorTaskStore,BackfillSourceStore,BalanceEventPersistorTaskStore,BlockSeqCreatorTaskStore,BlockSeqPersistorTaskStore,ConfigParser,DebondingDelegationSeqCreatorTaskStore,DelegationSeqCreatorTaskStore,SourceIndexStore,StakingSeqCreatorTaskStore,SyncerPersistorTaskStore,SyncerTaskStore,SystemEventCreatorStore,TransactionSeqCreatorTaskStore,ValidatorAggCreatorTaskStore,ValidatorAggPersistorTaskStore,ValidatorSeqCreatorTaskStore,ValidatorSeqPersistorTaskStore)
+// Source: github.com/figment-networks/oasishub-indexer/indexer (interfaces: AccountAggCreatorTaskStore,BackfillSourceStore,BalanceEventPersistorTaskStore,BlockSeqCreatorTaskStore,BlockSeqPersistorTaskStore,ConfigParser,DebondingDelegationSeqCreatorTaskStore,DelegationSeqCreatorTaskStore,SourceIndexStore,StakingSeqCreatorTaskStore,SyncerPersistorTaskStore,SyncerTaskStore,SystemEventCreatorStore,TransactionSeqCreatorTaskStore,ValidatorAggCreatorTaskStore,ValidatorAggPersistorTaskStore,ValidatorSeqCreatorTaskStore,ValidatorSeqPersistorTaskStore,DebondingDelegationPersistorTaskStore)
 
 // Package mock_indexer is a generated GoMock package.
 package mock_indexer
@@ -7,8 +7,10 @@ package mock_indexer
 import (
 	pipeline "github.com/figment-networks/indexing-engine/pipeline"
 	model "github.com/figment-networks/oasishub-indexer/model"
+	store "github.com/figment-networks/oasishub-indexer/store"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockAccountAggCreatorTaskStore is a mock of AccountAggCreatorTaskStore interface
@@ -336,6 +338,21 @@ func (mr *MockConfigParserMockRecorder) GetCurrentVersionId() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentVersionId", reflect.TypeOf((*MockConfigParser)(nil).GetCurrentVersionId))
 }
 
+// GetFirstVersionIdByTargetId mocks base method
+func (m *MockConfigParser) GetFirstVersionIdByTargetId(arg0 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstVersionIdByTargetId", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstVersionIdByTargetId indicates an expected call of GetFirstVersionIdByTargetId
+func (mr *MockConfigParserMockRecorder) GetFirstVersionIdByTargetId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstVersionIdByTargetId", reflect.TypeOf((*MockConfigParser)(nil).GetFirstVersionIdByTargetId), arg0)
+}
+
 // GetTasksByTargetIds mocks base method
 func (m *MockConfigParser) GetTasksByTargetIds(arg0 []int64) ([]pipeline.TaskName, error) {
 	m.ctrl.T.Helper()
@@ -484,6 +501,234 @@ func (mr *MockDelegationSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDelegationSeqCreatorTaskStore)(nil).FindByHeight), arg0)
 }
 
+// MockDelegatorSystemEventCreatorBalanceStore is a mock of DelegatorSystemEventCreatorBalanceStore interface
+type MockDelegatorSystemEventCreatorBalanceStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegatorSystemEventCreatorBalanceStoreMockRecorder
+}
+
+// MockDelegatorSystemEventCreatorBalanceStoreMockRecorder is the mock recorder for MockDelegatorSystemEventCreatorBalanceStore
+type MockDelegatorSystemEventCreatorBalanceStoreMockRecorder struct {
+	mock *MockDelegatorSystemEventCreatorBalanceStore
+}
+
+// NewMockDelegatorSystemEventCreatorBalanceStore creates a new mock instance
+func NewMockDelegatorSystemEventCreatorBalanceStore(ctrl *gomock.Controller) *MockDelegatorSystemEventCreatorBalanceStore {
+	mock := &MockDelegatorSystemEventCreatorBalanceStore{ctrl: ctrl}
+	mock.recorder = &MockDelegatorSystemEventCreatorBalanceStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDelegatorSystemEventCreatorBalanceStore) EXPECT() *MockDelegatorSystemEventCreatorBalanceStoreMockRecorder {
+	return m.recorder
+}
+
+// FindRewardTotals mocks base method
+func (m *MockDelegatorSystemEventCreatorBalanceStore) FindRewardTotals(arg0 time.Time, arg1 time.Time) ([]store.RewardTotalRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRewardTotals", arg0, arg1)
+	ret0, _ := ret[0].([]store.RewardTotalRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRewardTotals indicates an expected call of FindRewardTotals
+func (mr *MockDelegatorSystemEventCreatorBalanceStoreMockRecorder) FindRewardTotals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRewardTotals", reflect.TypeOf((*MockDelegatorSystemEventCreatorBalanceStore)(nil).FindRewardTotals), arg0, arg1)
+}
+
+// MockDelegatorSystemEventCreatorDebondingStore is a mock of DelegatorSystemEventCreatorDebondingStore interface
+type MockDelegatorSystemEventCreatorDebondingStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegatorSystemEventCreatorDebondingStoreMockRecorder
+}
+
+// MockDelegatorSystemEventCreatorDebondingStoreMockRecorder is the mock recorder for MockDelegatorSystemEventCreatorDebondingStore
+type MockDelegatorSystemEventCreatorDebondingStoreMockRecorder struct {
+	mock *MockDelegatorSystemEventCreatorDebondingStore
+}
+
+// NewMockDelegatorSystemEventCreatorDebondingStore creates a new mock instance
+func NewMockDelegatorSystemEventCreatorDebondingStore(ctrl *gomock.Controller) *MockDelegatorSystemEventCreatorDebondingStore {
+	mock := &MockDelegatorSystemEventCreatorDebondingStore{ctrl: ctrl}
+	mock.recorder = &MockDelegatorSystemEventCreatorDebondingStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDelegatorSystemEventCreatorDebondingStore) EXPECT() *MockDelegatorSystemEventCreatorDebondingStoreMockRecorder {
+	return m.recorder
+}
+
+// FindActiveAt mocks base method
+func (m *MockDelegatorSystemEventCreatorDebondingStore) FindActiveAt(arg0 int64) ([]model.DebondingDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveAt", arg0)
+	ret0, _ := ret[0].([]model.DebondingDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveAt indicates an expected call of FindActiveAt
+func (mr *MockDelegatorSystemEventCreatorDebondingStoreMockRecorder) FindActiveAt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveAt", reflect.TypeOf((*MockDelegatorSystemEventCreatorDebondingStore)(nil).FindActiveAt), arg0)
+}
+
+// MockDelegatorSystemEventCreatorSyncableStore is a mock of DelegatorSystemEventCreatorSyncableStore interface
+type MockDelegatorSystemEventCreatorSyncableStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegatorSystemEventCreatorSyncableStoreMockRecorder
+}
+
+// MockDelegatorSystemEventCreatorSyncableStoreMockRecorder is the mock recorder for MockDelegatorSystemEventCreatorSyncableStore
+type MockDelegatorSystemEventCreatorSyncableStoreMockRecorder struct {
+	mock *MockDelegatorSystemEventCreatorSyncableStore
+}
+
+// NewMockDelegatorSystemEventCreatorSyncableStore creates a new mock instance
+func NewMockDelegatorSystemEventCreatorSyncableStore(ctrl *gomock.Controller) *MockDelegatorSystemEventCreatorSyncableStore {
+	mock := &MockDelegatorSystemEventCreatorSyncableStore{ctrl: ctrl}
+	mock.recorder = &MockDelegatorSystemEventCreatorSyncableStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDelegatorSystemEventCreatorSyncableStore) EXPECT() *MockDelegatorSystemEventCreatorSyncableStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByHeight mocks base method
+func (m *MockDelegatorSystemEventCreatorSyncableStore) FindByHeight(arg0 int64) (*model.Syncable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].(*model.Syncable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockDelegatorSystemEventCreatorSyncableStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDelegatorSystemEventCreatorSyncableStore)(nil).FindByHeight), arg0)
+}
+
+// MockNetworkSystemEventCreatorBlockSeqStore is a mock of NetworkSystemEventCreatorBlockSeqStore interface
+type MockNetworkSystemEventCreatorBlockSeqStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder
+}
+
+// MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder is the mock recorder for MockNetworkSystemEventCreatorBlockSeqStore
+type MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder struct {
+	mock *MockNetworkSystemEventCreatorBlockSeqStore
+}
+
+// NewMockNetworkSystemEventCreatorBlockSeqStore creates a new mock instance
+func NewMockNetworkSystemEventCreatorBlockSeqStore(ctrl *gomock.Controller) *MockNetworkSystemEventCreatorBlockSeqStore {
+	mock := &MockNetworkSystemEventCreatorBlockSeqStore{ctrl: ctrl}
+	mock.recorder = &MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNetworkSystemEventCreatorBlockSeqStore) EXPECT() *MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder {
+	return m.recorder
+}
+
+// GetAvgRecentTimes mocks base method
+func (m *MockNetworkSystemEventCreatorBlockSeqStore) GetAvgRecentTimes(arg0 int64) (*store.GetAvgRecentTimesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvgRecentTimes", arg0)
+	ret0, _ := ret[0].(*store.GetAvgRecentTimesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvgRecentTimes indicates an expected call of GetAvgRecentTimes
+func (mr *MockNetworkSystemEventCreatorBlockSeqStoreMockRecorder) GetAvgRecentTimes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvgRecentTimes", reflect.TypeOf((*MockNetworkSystemEventCreatorBlockSeqStore)(nil).GetAvgRecentTimes), arg0)
+}
+
+// MockNetworkSystemEventCreatorStakingSeqStore is a mock of NetworkSystemEventCreatorStakingSeqStore interface
+type MockNetworkSystemEventCreatorStakingSeqStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder
+}
+
+// MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder is the mock recorder for MockNetworkSystemEventCreatorStakingSeqStore
+type MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder struct {
+	mock *MockNetworkSystemEventCreatorStakingSeqStore
+}
+
+// NewMockNetworkSystemEventCreatorStakingSeqStore creates a new mock instance
+func NewMockNetworkSystemEventCreatorStakingSeqStore(ctrl *gomock.Controller) *MockNetworkSystemEventCreatorStakingSeqStore {
+	mock := &MockNetworkSystemEventCreatorStakingSeqStore{ctrl: ctrl}
+	mock.recorder = &MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNetworkSystemEventCreatorStakingSeqStore) EXPECT() *MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByHeight mocks base method
+func (m *MockNetworkSystemEventCreatorStakingSeqStore) FindByHeight(arg0 int64) (*model.StakingSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].(*model.StakingSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockNetworkSystemEventCreatorStakingSeqStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockNetworkSystemEventCreatorStakingSeqStore)(nil).FindByHeight), arg0)
+}
+
+// MockNetworkSystemEventCreatorValidatorSeqStore is a mock of NetworkSystemEventCreatorValidatorSeqStore interface
+type MockNetworkSystemEventCreatorValidatorSeqStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder
+}
+
+// MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder is the mock recorder for MockNetworkSystemEventCreatorValidatorSeqStore
+type MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder struct {
+	mock *MockNetworkSystemEventCreatorValidatorSeqStore
+}
+
+// NewMockNetworkSystemEventCreatorValidatorSeqStore creates a new mock instance
+func NewMockNetworkSystemEventCreatorValidatorSeqStore(ctrl *gomock.Controller) *MockNetworkSystemEventCreatorValidatorSeqStore {
+	mock := &MockNetworkSystemEventCreatorValidatorSeqStore{ctrl: ctrl}
+	mock.recorder = &MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNetworkSystemEventCreatorValidatorSeqStore) EXPECT() *MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByHeight mocks base method
+func (m *MockNetworkSystemEventCreatorValidatorSeqStore) FindByHeight(arg0 int64) ([]model.ValidatorSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.ValidatorSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockNetworkSystemEventCreatorValidatorSeqStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockNetworkSystemEventCreatorValidatorSeqStore)(nil).FindByHeight), arg0)
+}
+
 // MockSourceIndexStore is a mock of SourceIndexStore interface
 type MockSourceIndexStore struct {
 	ctrl     *gomock.Controller
@@ -688,7 +933,7 @@ func (mr *MockSystemEventCreatorStoreMockRecorder) FindByHeight(arg0 interface{}
 }
 
 // FindLastByAddress mocks base method
-func (m *MockSystemEventCreatorStore) FindLastByAddress(arg0 string, arg1 int64) ([]model.ValidatorSeq, error) {
+func (m *MockSystemEventCreatorStore) FindLastByAddress(arg0 string, arg1 store.Pagination) ([]model.ValidatorSeq, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindLastByAddress", arg0, arg1)
 	ret0, _ := ret[0].([]model.ValidatorSeq)
@@ -702,6 +947,44 @@ func (mr *MockSystemEventCreatorStoreMockRecorder) FindLastByAddress(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByAddress", reflect.TypeOf((*MockSystemEventCreatorStore)(nil).FindLastByAddress), arg0, arg1)
 }
 
+// MockSystemEventCreatorUptimeStore is a mock of SystemEventCreatorUptimeStore interface
+type MockSystemEventCreatorUptimeStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSystemEventCreatorUptimeStoreMockRecorder
+}
+
+// MockSystemEventCreatorUptimeStoreMockRecorder is the mock recorder for MockSystemEventCreatorUptimeStore
+type MockSystemEventCreatorUptimeStoreMockRecorder struct {
+	mock *MockSystemEventCreatorUptimeStore
+}
+
+// NewMockSystemEventCreatorUptimeStore creates a new mock instance
+func NewMockSystemEventCreatorUptimeStore(ctrl *gomock.Controller) *MockSystemEventCreatorUptimeStore {
+	mock := &MockSystemEventCreatorUptimeStore{ctrl: ctrl}
+	mock.recorder = &MockSystemEventCreatorUptimeStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSystemEventCreatorUptimeStore) EXPECT() *MockSystemEventCreatorUptimeStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByAddresses mocks base method
+func (m *MockSystemEventCreatorUptimeStore) FindByAddresses(arg0 []string) ([]model.ValidatorUptime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddresses", arg0)
+	ret0, _ := ret[0].([]model.ValidatorUptime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAddresses indicates an expected call of FindByAddresses
+func (mr *MockSystemEventCreatorUptimeStoreMockRecorder) FindByAddresses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddresses", reflect.TypeOf((*MockSystemEventCreatorUptimeStore)(nil).FindByAddresses), arg0)
+}
+
 // MockTransactionSeqCreatorTaskStore is a mock of TransactionSeqCreatorTaskStore interface
 type MockTransactionSeqCreatorTaskStore struct {
 	ctrl     *gomock.Controller
@@ -931,3 +1214,68 @@ func (mr *MockValidatorSeqPersistorTaskStoreMockRecorder) Save(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockValidatorSeqPersistorTaskStore)(nil).Save), arg0)
 }
+
+// MockDebondingDelegationPersistorTaskStore is a mock of DebondingDelegationPersistorTaskStore interface
+type MockDebondingDelegationPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebondingDelegationPersistorTaskStoreMockRecorder
+}
+
+// MockDebondingDelegationPersistorTaskStoreMockRecorder is the mock recorder for MockDebondingDelegationPersistorTaskStore
+type MockDebondingDelegationPersistorTaskStoreMockRecorder struct {
+	mock *MockDebondingDelegationPersistorTaskStore
+}
+
+// NewMockDebondingDelegationPersistorTaskStore creates a new mock instance
+func NewMockDebondingDelegationPersistorTaskStore(ctrl *gomock.Controller) *MockDebondingDelegationPersistorTaskStore {
+	mock := &MockDebondingDelegationPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockDebondingDelegationPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDebondingDelegationPersistorTaskStore) EXPECT() *MockDebondingDelegationPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockDebondingDelegationPersistorTaskStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockDebondingDelegationPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDebondingDelegationPersistorTaskStore)(nil).Create), arg0)
+}
+
+// Revert mocks base method
+func (m *MockDebondingDelegationPersistorTaskStore) Revert(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revert indicates an expected call of Revert
+func (mr *MockDebondingDelegationPersistorTaskStoreMockRecorder) Revert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockDebondingDelegationPersistorTaskStore)(nil).Revert), arg0)
+}
+
+// Save mocks base method
+func (m *MockDebondingDelegationPersistorTaskStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockDebondingDelegationPersistorTaskStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDebondingDelegationPersistorTaskStore)(nil).Save), arg0)
+}
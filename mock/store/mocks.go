@@ -1,10 +1,11 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/oasishub-indexer/store (interfaces: DatabaseStore,SyncablesStore,ReportsStore,SystemEventsStore,BlockSeqStore,DebondingDelegationSeqStore,DelegationSeqStore,StakingSeqStore,TransactionSeqStore,ValidatorSeqStore,BlockSummaryStore,ValidatorSummaryStore,AccountAggStore,ValidatorAggStore)
+// Source: github.com/figment-networks/oasishub-indexer/store (interfaces: DatabaseStore,SyncablesStore,ReportsStore,SystemEventsStore,BlockSeqStore,DebondingDelegationSeqStore,DelegationSeqStore,StakingSeqStore,TransactionSeqStore,ValidatorSeqStore,BlockSummaryStore,ValidatorSummaryStore,AccountAggStore,ValidatorAggStore,SummaryWatermarksStore,RetentionStore,WebhookSubscriptionsStore,WebhookDeliveriesStore,SystemEventAcksStore,ValidatorMutesStore,BalanceSummaryStore,DebondingDelegationsStore)
 
 // Package mock_store is a generated GoMock package.
 package mock_store
 
 import (
+	json "encoding/json"
 	model "github.com/figment-networks/oasishub-indexer/model"
 	store "github.com/figment-networks/oasishub-indexer/store"
 	types "github.com/figment-networks/oasishub-indexer/types"
@@ -147,6 +148,21 @@ func (mr *MockSyncablesStoreMockRecorder) FindMostRecent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecent", reflect.TypeOf((*MockSyncablesStore)(nil).FindMostRecent))
 }
 
+// FindMostRecentAt mocks base method
+func (m *MockSyncablesStore) FindMostRecentAt(arg0 time.Time) (*model.Syncable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMostRecentAt", arg0)
+	ret0, _ := ret[0].(*model.Syncable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMostRecentAt indicates an expected call of FindMostRecentAt
+func (mr *MockSyncablesStoreMockRecorder) FindMostRecentAt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentAt", reflect.TypeOf((*MockSyncablesStore)(nil).FindMostRecentAt), arg0)
+}
+
 // FindMostRecentByDifferentIndexVersion mocks base method
 func (m *MockSyncablesStore) FindMostRecentByDifferentIndexVersion(arg0 int64) (*model.Syncable, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +178,21 @@ func (mr *MockSyncablesStoreMockRecorder) FindMostRecentByDifferentIndexVersion(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentByDifferentIndexVersion", reflect.TypeOf((*MockSyncablesStore)(nil).FindMostRecentByDifferentIndexVersion), arg0)
 }
 
+// FindProcessedHeights mocks base method
+func (m *MockSyncablesStore) FindProcessedHeights(arg0, arg1, arg2 int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProcessedHeights", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProcessedHeights indicates an expected call of FindProcessedHeights
+func (mr *MockSyncablesStoreMockRecorder) FindProcessedHeights(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProcessedHeights", reflect.TypeOf((*MockSyncablesStore)(nil).FindProcessedHeights), arg0, arg1, arg2)
+}
+
 // FindSmallestIndexVersion mocks base method
 func (m *MockSyncablesStore) FindSmallestIndexVersion() (*int64, error) {
 	m.ctrl.T.Helper()
@@ -403,34 +434,19 @@ func (mr *MockSystemEventsStoreMockRecorder) CreateOrUpdate(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockSystemEventsStore)(nil).CreateOrUpdate), arg0)
 }
 
-// DeleteOlderThan mocks base method
-func (m *MockSystemEventsStore) DeleteOlderThan(arg0 time.Time) (*int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0)
-	ret0, _ := ret[0].(*int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeleteOlderThan indicates an expected call of DeleteOlderThan
-func (mr *MockSystemEventsStoreMockRecorder) DeleteOlderThan(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockSystemEventsStore)(nil).DeleteOlderThan), arg0)
-}
-
 // FindByActor mocks base method
-func (m *MockSystemEventsStore) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+func (m *MockSystemEventsStore) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery, arg2 store.Pagination) ([]model.SystemEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]model.SystemEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByActor indicates an expected call of FindByActor
-func (mr *MockSystemEventsStoreMockRecorder) FindByActor(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockSystemEventsStoreMockRecorder) FindByActor(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEventsStore)(nil).FindByActor), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActor", reflect.TypeOf((*MockSystemEventsStore)(nil).FindByActor), arg0, arg1, arg2)
 }
 
 // FindByHeight mocks base method
@@ -448,6 +464,36 @@ func (mr *MockSystemEventsStoreMockRecorder) FindByHeight(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockSystemEventsStore)(nil).FindByHeight), arg0)
 }
 
+// FindByID mocks base method
+func (m *MockSystemEventsStore) FindByID(arg0 types.ID) (*model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockSystemEventsStoreMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSystemEventsStore)(nil).FindByID), arg0)
+}
+
+// FindLastByActors mocks base method
+func (m *MockSystemEventsStore) FindLastByActors(arg0 []string, arg1 *model.SystemEventKind, arg2 int64) ([]model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastByActors", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastByActors indicates an expected call of FindLastByActors
+func (mr *MockSystemEventsStoreMockRecorder) FindLastByActors(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByActors", reflect.TypeOf((*MockSystemEventsStore)(nil).FindLastByActors), arg0, arg1, arg2)
+}
+
 // FindMostRecent mocks base method
 func (m *MockSystemEventsStore) FindMostRecent() (*model.SystemEvent, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +509,21 @@ func (mr *MockSystemEventsStoreMockRecorder) FindMostRecent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecent", reflect.TypeOf((*MockSystemEventsStore)(nil).FindMostRecent))
 }
 
+// FindNew mocks base method
+func (m *MockSystemEventsStore) FindNew(arg0 store.FindNewSystemEventsQuery) ([]model.SystemEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNew", arg0)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNew indicates an expected call of FindNew
+func (mr *MockSystemEventsStoreMockRecorder) FindNew(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNew", reflect.TypeOf((*MockSystemEventsStore)(nil).FindNew), arg0)
+}
+
 // FindUnique mocks base method
 func (m *MockSystemEventsStore) FindUnique(arg0 int64, arg1 string, arg2 model.SystemEventKind) (*model.SystemEvent, error) {
 	m.ctrl.T.Helper()
@@ -543,21 +604,6 @@ func (mr *MockBlockSeqStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBlockSeqStore)(nil).Create), arg0)
 }
 
-// DeleteOlderThan mocks base method
-func (m *MockBlockSeqStore) DeleteOlderThan(arg0 time.Time, arg1 []store.ActivityPeriodRow) (*int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0, arg1)
-	ret0, _ := ret[0].(*int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeleteOlderThan indicates an expected call of DeleteOlderThan
-func (mr *MockBlockSeqStoreMockRecorder) DeleteOlderThan(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockBlockSeqStore)(nil).DeleteOlderThan), arg0, arg1)
-}
-
 // FindBy mocks base method
 func (m *MockBlockSeqStore) FindBy(arg0 string, arg1 interface{}) (*model.BlockSeq, error) {
 	m.ctrl.T.Helper()
@@ -588,6 +634,21 @@ func (mr *MockBlockSeqStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockBlockSeqStore)(nil).FindByHeight), arg0)
 }
 
+// FindChangesSince mocks base method
+func (m *MockBlockSeqStore) FindChangesSince(arg0 int64) (*store.ChangeSetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesSince", arg0)
+	ret0, _ := ret[0].(*store.ChangeSetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesSince indicates an expected call of FindChangesSince
+func (mr *MockBlockSeqStoreMockRecorder) FindChangesSince(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesSince", reflect.TypeOf((*MockBlockSeqStore)(nil).FindChangesSince), arg0)
+}
+
 // FindMostRecent mocks base method
 func (m *MockBlockSeqStore) FindMostRecent() (*model.BlockSeq, error) {
 	m.ctrl.T.Helper()
@@ -604,11 +665,12 @@ func (mr *MockBlockSeqStoreMockRecorder) FindMostRecent() *gomock.Call {
 }
 
 // GetAvgRecentTimes mocks base method
-func (m *MockBlockSeqStore) GetAvgRecentTimes(arg0 int64) store.GetAvgRecentTimesResult {
+func (m *MockBlockSeqStore) GetAvgRecentTimes(arg0 int64) (*store.GetAvgRecentTimesResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAvgRecentTimes", arg0)
-	ret0, _ := ret[0].(store.GetAvgRecentTimesResult)
-	return ret0
+	ret0, _ := ret[0].(*store.GetAvgRecentTimesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetAvgRecentTimes indicates an expected call of GetAvgRecentTimes
@@ -632,7 +694,7 @@ func (mr *MockBlockSeqStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
 }
 
 // Summarize mocks base method
-func (m *MockBlockSeqStore) Summarize(arg0 types.SummaryInterval, arg1 []store.ActivityPeriodRow) ([]store.BlockSeqSummary, error) {
+func (m *MockBlockSeqStore) Summarize(arg0 types.SummaryInterval, arg1 time.Time) ([]store.BlockSeqSummary, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Summarize", arg0, arg1)
 	ret0, _ := ret[0].([]store.BlockSeqSummary)
@@ -1107,21 +1169,6 @@ func (mr *MockValidatorSeqStoreMockRecorder) Create(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockValidatorSeqStore)(nil).Create), arg0)
 }
 
-// DeleteOlderThan mocks base method
-func (m *MockValidatorSeqStore) DeleteOlderThan(arg0 time.Time) (*int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0)
-	ret0, _ := ret[0].(*int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeleteOlderThan indicates an expected call of DeleteOlderThan
-func (mr *MockValidatorSeqStoreMockRecorder) DeleteOlderThan(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockValidatorSeqStore)(nil).DeleteOlderThan), arg0)
-}
-
 // FindByHeight mocks base method
 func (m *MockValidatorSeqStore) FindByHeight(arg0 int64) ([]model.ValidatorSeq, error) {
 	m.ctrl.T.Helper()
@@ -1152,8 +1199,38 @@ func (mr *MockValidatorSeqStoreMockRecorder) FindByHeightAndEntityUID(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeightAndEntityUID", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindByHeightAndEntityUID), arg0, arg1)
 }
 
+// FindChangesSince mocks base method
+func (m *MockValidatorSeqStore) FindChangesSince(arg0 int64) (*store.ChangeSetRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesSince", arg0)
+	ret0, _ := ret[0].(*store.ChangeSetRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesSince indicates an expected call of FindChangesSince
+func (mr *MockValidatorSeqStoreMockRecorder) FindChangesSince(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesSince", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindChangesSince), arg0)
+}
+
+// FindFirst mocks base method
+func (m *MockValidatorSeqStore) FindFirst() (*model.ValidatorSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFirst")
+	ret0, _ := ret[0].(*model.ValidatorSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFirst indicates an expected call of FindFirst
+func (mr *MockValidatorSeqStoreMockRecorder) FindFirst() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFirst", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindFirst))
+}
+
 // FindLastByAddress mocks base method
-func (m *MockValidatorSeqStore) FindLastByAddress(arg0 string, arg1 int64) ([]model.ValidatorSeq, error) {
+func (m *MockValidatorSeqStore) FindLastByAddress(arg0 string, arg1 store.Pagination) ([]model.ValidatorSeq, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindLastByAddress", arg0, arg1)
 	ret0, _ := ret[0].([]model.ValidatorSeq)
@@ -1167,6 +1244,21 @@ func (mr *MockValidatorSeqStoreMockRecorder) FindLastByAddress(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByAddress", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindLastByAddress), arg0, arg1)
 }
 
+// FindLastByAddresses mocks base method
+func (m *MockValidatorSeqStore) FindLastByAddresses(arg0 []string, arg1 int64) ([]model.ValidatorSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastByAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]model.ValidatorSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastByAddresses indicates an expected call of FindLastByAddresses
+func (mr *MockValidatorSeqStoreMockRecorder) FindLastByAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByAddresses", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindLastByAddresses), arg0, arg1)
+}
+
 // FindMostRecent mocks base method
 func (m *MockValidatorSeqStore) FindMostRecent() (*model.ValidatorSeq, error) {
 	m.ctrl.T.Helper()
@@ -1197,7 +1289,7 @@ func (mr *MockValidatorSeqStoreMockRecorder) Save(arg0 interface{}) *gomock.Call
 }
 
 // Summarize mocks base method
-func (m *MockValidatorSeqStore) Summarize(arg0 types.SummaryInterval, arg1 []store.ActivityPeriodRow) ([]store.ValidatorSeqSummary, error) {
+func (m *MockValidatorSeqStore) Summarize(arg0 types.SummaryInterval, arg1 time.Time) ([]store.ValidatorSeqSummary, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Summarize", arg0, arg1)
 	ret0, _ := ret[0].([]store.ValidatorSeqSummary)
@@ -1262,21 +1354,6 @@ func (mr *MockBlockSummaryStoreMockRecorder) Create(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBlockSummaryStore)(nil).Create), arg0)
 }
 
-// DeleteOlderThan mocks base method
-func (m *MockBlockSummaryStore) DeleteOlderThan(arg0 types.SummaryInterval, arg1 time.Time) (*int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0, arg1)
-	ret0, _ := ret[0].(*int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeleteOlderThan indicates an expected call of DeleteOlderThan
-func (mr *MockBlockSummaryStoreMockRecorder) DeleteOlderThan(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockBlockSummaryStore)(nil).DeleteOlderThan), arg0, arg1)
-}
-
 // Find mocks base method
 func (m *MockBlockSummaryStore) Find(arg0 *model.BlockSummary) (*model.BlockSummary, error) {
 	m.ctrl.T.Helper()
@@ -1292,21 +1369,6 @@ func (mr *MockBlockSummaryStoreMockRecorder) Find(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBlockSummaryStore)(nil).Find), arg0)
 }
 
-// FindActivityPeriods mocks base method
-func (m *MockBlockSummaryStore) FindActivityPeriods(arg0 types.SummaryInterval, arg1 int64) ([]store.ActivityPeriodRow, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindActivityPeriods", arg0, arg1)
-	ret0, _ := ret[0].([]store.ActivityPeriodRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindActivityPeriods indicates an expected call of FindActivityPeriods
-func (mr *MockBlockSummaryStoreMockRecorder) FindActivityPeriods(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActivityPeriods", reflect.TypeOf((*MockBlockSummaryStore)(nil).FindActivityPeriods), arg0, arg1)
-}
-
 // FindMostRecentByInterval mocks base method
 func (m *MockBlockSummaryStore) FindMostRecentByInterval(arg0 types.SummaryInterval) (*model.BlockSummary, error) {
 	m.ctrl.T.Helper()
@@ -1337,6 +1399,21 @@ func (mr *MockBlockSummaryStoreMockRecorder) FindSummary(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummary", reflect.TypeOf((*MockBlockSummaryStore)(nil).FindSummary), arg0, arg1)
 }
 
+// Rollup mocks base method
+func (m *MockBlockSummaryStore) Rollup(arg0, arg1 types.SummaryInterval, arg2 time.Time, arg3 int64) ([]store.BlockSeqSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]store.BlockSeqSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockBlockSummaryStoreMockRecorder) Rollup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockBlockSummaryStore)(nil).Rollup), arg0, arg1, arg2, arg3)
+}
+
 // Save mocks base method
 func (m *MockBlockSummaryStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1388,6 +1465,21 @@ func (m *MockValidatorSummaryStore) EXPECT() *MockValidatorSummaryStoreMockRecor
 	return m.recorder
 }
 
+// BackfillSharePrice mocks base method
+func (m *MockValidatorSummaryStore) BackfillSharePrice(arg0 types.SummaryInterval, arg1 time.Time, arg2 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillSharePrice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillSharePrice indicates an expected call of BackfillSharePrice
+func (mr *MockValidatorSummaryStoreMockRecorder) BackfillSharePrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillSharePrice", reflect.TypeOf((*MockValidatorSummaryStore)(nil).BackfillSharePrice), arg0, arg1, arg2)
+}
+
 // Create mocks base method
 func (m *MockValidatorSummaryStore) Create(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1402,21 +1494,6 @@ func (mr *MockValidatorSummaryStoreMockRecorder) Create(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockValidatorSummaryStore)(nil).Create), arg0)
 }
 
-// DeleteOlderThan mocks base method
-func (m *MockValidatorSummaryStore) DeleteOlderThan(arg0 types.SummaryInterval, arg1 time.Time) (*int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", arg0, arg1)
-	ret0, _ := ret[0].(*int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeleteOlderThan indicates an expected call of DeleteOlderThan
-func (mr *MockValidatorSummaryStoreMockRecorder) DeleteOlderThan(arg0, arg1 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockValidatorSummaryStore)(nil).DeleteOlderThan), arg0, arg1)
-}
-
 // Find mocks base method
 func (m *MockValidatorSummaryStore) Find(arg0 *model.ValidatorSummary) (*model.ValidatorSummary, error) {
 	m.ctrl.T.Helper()
@@ -1432,19 +1509,19 @@ func (mr *MockValidatorSummaryStoreMockRecorder) Find(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockValidatorSummaryStore)(nil).Find), arg0)
 }
 
-// FindActivityPeriods mocks base method
-func (m *MockValidatorSummaryStore) FindActivityPeriods(arg0 types.SummaryInterval, arg1 int64) ([]store.ActivityPeriodRow, error) {
+// FindActiveEscrowBalanceForPeriod mocks base method
+func (m *MockValidatorSummaryStore) FindActiveEscrowBalanceForPeriod(arg0 types.SummaryInterval, arg1 time.Time, arg2 time.Time) ([]store.ValidatorPeriodBalanceRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindActivityPeriods", arg0, arg1)
-	ret0, _ := ret[0].([]store.ActivityPeriodRow)
+	ret := m.ctrl.Call(m, "FindActiveEscrowBalanceForPeriod", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.ValidatorPeriodBalanceRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindActivityPeriods indicates an expected call of FindActivityPeriods
-func (mr *MockValidatorSummaryStoreMockRecorder) FindActivityPeriods(arg0, arg1 interface{}) *gomock.Call {
+// FindActiveEscrowBalanceForPeriod indicates an expected call of FindActiveEscrowBalanceForPeriod
+func (mr *MockValidatorSummaryStoreMockRecorder) FindActiveEscrowBalanceForPeriod(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActivityPeriods", reflect.TypeOf((*MockValidatorSummaryStore)(nil).FindActivityPeriods), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveEscrowBalanceForPeriod", reflect.TypeOf((*MockValidatorSummaryStore)(nil).FindActiveEscrowBalanceForPeriod), arg0, arg1, arg2)
 }
 
 // FindMostRecent mocks base method
@@ -1507,6 +1584,36 @@ func (mr *MockValidatorSummaryStoreMockRecorder) FindSummaryByAddress(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummaryByAddress", reflect.TypeOf((*MockValidatorSummaryStore)(nil).FindSummaryByAddress), arg0, arg1, arg2)
 }
 
+// FindUptimeForPeriod mocks base method
+func (m *MockValidatorSummaryStore) FindUptimeForPeriod(arg0 types.SummaryInterval, arg1 time.Time, arg2 time.Time) ([]store.ValidatorPeriodUptimeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUptimeForPeriod", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.ValidatorPeriodUptimeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUptimeForPeriod indicates an expected call of FindUptimeForPeriod
+func (mr *MockValidatorSummaryStoreMockRecorder) FindUptimeForPeriod(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUptimeForPeriod", reflect.TypeOf((*MockValidatorSummaryStore)(nil).FindUptimeForPeriod), arg0, arg1, arg2)
+}
+
+// Rollup mocks base method
+func (m *MockValidatorSummaryStore) Rollup(arg0, arg1 types.SummaryInterval, arg2 time.Time, arg3 int64) ([]store.ValidatorSeqSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]store.ValidatorSeqSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockValidatorSummaryStoreMockRecorder) Rollup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockValidatorSummaryStore)(nil).Rollup), arg0, arg1, arg2, arg3)
+}
+
 // Save mocks base method
 func (m *MockValidatorSummaryStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1602,6 +1709,21 @@ func (mr *MockAccountAggStoreMockRecorder) FindByPublicKey(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPublicKey", reflect.TypeOf((*MockAccountAggStore)(nil).FindByPublicKey), arg0)
 }
 
+// FindByPublicKeys mocks base method
+func (m *MockAccountAggStore) FindByPublicKeys(arg0 []string) ([]model.AccountAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPublicKeys", arg0)
+	ret0, _ := ret[0].([]model.AccountAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPublicKeys indicates an expected call of FindByPublicKeys
+func (mr *MockAccountAggStoreMockRecorder) FindByPublicKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPublicKeys", reflect.TypeOf((*MockAccountAggStore)(nil).FindByPublicKeys), arg0)
+}
+
 // Save mocks base method
 func (m *MockAccountAggStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1681,6 +1803,21 @@ func (mr *MockValidatorAggStoreMockRecorder) CreateOrUpdate(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockValidatorAggStore)(nil).CreateOrUpdate), arg0)
 }
 
+// FindAll mocks base method
+func (m *MockValidatorAggStore) FindAll() ([]model.ValidatorAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]model.ValidatorAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockValidatorAggStoreMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockValidatorAggStore)(nil).FindAll))
+}
+
 // FindBy mocks base method
 func (m *MockValidatorAggStore) FindBy(arg0 string, arg1 interface{}) (*model.ValidatorAgg, error) {
 	m.ctrl.T.Helper()
@@ -1711,6 +1848,21 @@ func (mr *MockValidatorAggStoreMockRecorder) FindByAddress(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddress", reflect.TypeOf((*MockValidatorAggStore)(nil).FindByAddress), arg0)
 }
 
+// FindByAddresses mocks base method
+func (m *MockValidatorAggStore) FindByAddresses(arg0 []string) ([]model.ValidatorAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAddresses", arg0)
+	ret0, _ := ret[0].([]model.ValidatorAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAddresses indicates an expected call of FindByAddresses
+func (mr *MockValidatorAggStoreMockRecorder) FindByAddresses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAddresses", reflect.TypeOf((*MockValidatorAggStore)(nil).FindByAddresses), arg0)
+}
+
 // FindByEntityUID mocks base method
 func (m *MockValidatorAggStore) FindByEntityUID(arg0 string) (*model.ValidatorAgg, error) {
 	m.ctrl.T.Helper()
@@ -1727,18 +1879,18 @@ func (mr *MockValidatorAggStoreMockRecorder) FindByEntityUID(arg0 interface{}) *
 }
 
 // GetAllForHeightGreaterThan mocks base method
-func (m *MockValidatorAggStore) GetAllForHeightGreaterThan(arg0 int64) ([]model.ValidatorAgg, error) {
+func (m *MockValidatorAggStore) GetAllForHeightGreaterThan(arg0 int64, arg1 store.Pagination) ([]model.ValidatorAgg, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllForHeightGreaterThan", arg0)
+	ret := m.ctrl.Call(m, "GetAllForHeightGreaterThan", arg0, arg1)
 	ret0, _ := ret[0].([]model.ValidatorAgg)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllForHeightGreaterThan indicates an expected call of GetAllForHeightGreaterThan
-func (mr *MockValidatorAggStoreMockRecorder) GetAllForHeightGreaterThan(arg0 interface{}) *gomock.Call {
+func (mr *MockValidatorAggStoreMockRecorder) GetAllForHeightGreaterThan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllForHeightGreaterThan", reflect.TypeOf((*MockValidatorAggStore)(nil).GetAllForHeightGreaterThan), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllForHeightGreaterThan", reflect.TypeOf((*MockValidatorAggStore)(nil).GetAllForHeightGreaterThan), arg0, arg1)
 }
 
 // Save mocks base method
@@ -1768,3 +1920,834 @@ func (mr *MockValidatorAggStoreMockRecorder) Update(arg0 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockValidatorAggStore)(nil).Update), arg0)
 }
+
+// MockSummaryWatermarksStore is a mock of SummaryWatermarksStore interface
+type MockSummaryWatermarksStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSummaryWatermarksStoreMockRecorder
+}
+
+// MockSummaryWatermarksStoreMockRecorder is the mock recorder for MockSummaryWatermarksStore
+type MockSummaryWatermarksStoreMockRecorder struct {
+	mock *MockSummaryWatermarksStore
+}
+
+// NewMockSummaryWatermarksStore creates a new mock instance
+func NewMockSummaryWatermarksStore(ctrl *gomock.Controller) *MockSummaryWatermarksStore {
+	mock := &MockSummaryWatermarksStore{ctrl: ctrl}
+	mock.recorder = &MockSummaryWatermarksStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSummaryWatermarksStore) EXPECT() *MockSummaryWatermarksStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockSummaryWatermarksStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockSummaryWatermarksStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).Create), arg0)
+}
+
+// CreateOrUpdate mocks base method
+func (m *MockSummaryWatermarksStore) CreateOrUpdate(arg0 *model.SummaryWatermark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate
+func (mr *MockSummaryWatermarksStoreMockRecorder) CreateOrUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).CreateOrUpdate), arg0)
+}
+
+// FindBySource mocks base method
+func (m *MockSummaryWatermarksStore) FindBySource(arg0 string, arg1 int64) (*model.SummaryWatermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySource", arg0, arg1)
+	ret0, _ := ret[0].(*model.SummaryWatermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySource indicates an expected call of FindBySource
+func (mr *MockSummaryWatermarksStoreMockRecorder) FindBySource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySource", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).FindBySource), arg0, arg1)
+}
+
+// Rewind mocks base method
+func (m *MockSummaryWatermarksStore) Rewind(arg0 int64, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rewind", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rewind indicates an expected call of Rewind
+func (mr *MockSummaryWatermarksStoreMockRecorder) Rewind(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rewind", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).Rewind), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockSummaryWatermarksStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockSummaryWatermarksStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockSummaryWatermarksStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockSummaryWatermarksStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSummaryWatermarksStore)(nil).Update), arg0)
+}
+
+// MockRetentionStore is a mock of RetentionStore interface
+type MockRetentionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionStoreMockRecorder
+}
+
+// MockRetentionStoreMockRecorder is the mock recorder for MockRetentionStore
+type MockRetentionStoreMockRecorder struct {
+	mock *MockRetentionStore
+}
+
+// NewMockRetentionStore creates a new mock instance
+func NewMockRetentionStore(ctrl *gomock.Controller) *MockRetentionStore {
+	mock := &MockRetentionStore{ctrl: ctrl}
+	mock.recorder = &MockRetentionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRetentionStore) EXPECT() *MockRetentionStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteByIDs mocks base method
+func (m *MockRetentionStore) DeleteByIDs(arg0 string, arg1 []int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIDs", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByIDs indicates an expected call of DeleteByIDs
+func (mr *MockRetentionStoreMockRecorder) DeleteByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDs", reflect.TypeOf((*MockRetentionStore)(nil).DeleteByIDs), arg0, arg1)
+}
+
+// DeleteCovered mocks base method
+func (m *MockRetentionStore) DeleteCovered(arg0 store.RetentionQuery) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCovered", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCovered indicates an expected call of DeleteCovered
+func (mr *MockRetentionStoreMockRecorder) DeleteCovered(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCovered", reflect.TypeOf((*MockRetentionStore)(nil).DeleteCovered), arg0)
+}
+
+// ExportCovered mocks base method
+func (m *MockRetentionStore) ExportCovered(arg0 store.RetentionQuery, arg1 func(store.ExportedRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCovered", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportCovered indicates an expected call of ExportCovered
+func (mr *MockRetentionStoreMockRecorder) ExportCovered(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCovered", reflect.TypeOf((*MockRetentionStore)(nil).ExportCovered), arg0, arg1)
+}
+
+// FindMostRecentTime mocks base method
+func (m *MockRetentionStore) FindMostRecentTime(arg0 string, arg1 types.SummaryInterval) (*types.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMostRecentTime", arg0, arg1)
+	ret0, _ := ret[0].(*types.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMostRecentTime indicates an expected call of FindMostRecentTime
+func (mr *MockRetentionStoreMockRecorder) FindMostRecentTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentTime", reflect.TypeOf((*MockRetentionStore)(nil).FindMostRecentTime), arg0, arg1)
+}
+
+// FindUncovered mocks base method
+func (m *MockRetentionStore) FindUncovered(arg0 store.RetentionQuery) ([]store.UncoveredBucketRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUncovered", arg0)
+	ret0, _ := ret[0].([]store.UncoveredBucketRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUncovered indicates an expected call of FindUncovered
+func (mr *MockRetentionStoreMockRecorder) FindUncovered(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUncovered", reflect.TypeOf((*MockRetentionStore)(nil).FindUncovered), arg0)
+}
+
+// Import mocks base method
+func (m *MockRetentionStore) Import(arg0 string, arg1 []json.RawMessage) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import
+func (mr *MockRetentionStoreMockRecorder) Import(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockRetentionStore)(nil).Import), arg0, arg1)
+}
+
+// MockWebhookSubscriptionsStore is a mock of WebhookSubscriptionsStore interface
+type MockWebhookSubscriptionsStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionsStoreMockRecorder
+}
+
+// MockWebhookSubscriptionsStoreMockRecorder is the mock recorder for MockWebhookSubscriptionsStore
+type MockWebhookSubscriptionsStoreMockRecorder struct {
+	mock *MockWebhookSubscriptionsStore
+}
+
+// NewMockWebhookSubscriptionsStore creates a new mock instance
+func NewMockWebhookSubscriptionsStore(ctrl *gomock.Controller) *MockWebhookSubscriptionsStore {
+	mock := &MockWebhookSubscriptionsStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionsStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSubscriptionsStore) EXPECT() *MockWebhookSubscriptionsStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookSubscriptionsStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Create), arg0)
+}
+
+// DeleteByID mocks base method
+func (m *MockWebhookSubscriptionsStore) DeleteByID(arg0 types.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) DeleteByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).DeleteByID), arg0)
+}
+
+// FindAll mocks base method
+func (m *MockWebhookSubscriptionsStore) FindAll() ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).FindAll))
+}
+
+// FindByAPIKey mocks base method
+func (m *MockWebhookSubscriptionsStore) FindByAPIKey(arg0 types.ID) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAPIKey", arg0)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAPIKey indicates an expected call of FindByAPIKey
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) FindByAPIKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAPIKey", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).FindByAPIKey), arg0)
+}
+
+// FindByID mocks base method
+func (m *MockWebhookSubscriptionsStore) FindByID(arg0 types.ID) (*model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).FindByID), arg0)
+}
+
+// Save mocks base method
+func (m *MockWebhookSubscriptionsStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockWebhookSubscriptionsStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Update), arg0)
+}
+
+// MockWebhookDeliveriesStore is a mock of WebhookDeliveriesStore interface
+type MockWebhookDeliveriesStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveriesStoreMockRecorder
+}
+
+// MockWebhookDeliveriesStoreMockRecorder is the mock recorder for MockWebhookDeliveriesStore
+type MockWebhookDeliveriesStoreMockRecorder struct {
+	mock *MockWebhookDeliveriesStore
+}
+
+// NewMockWebhookDeliveriesStore creates a new mock instance
+func NewMockWebhookDeliveriesStore(ctrl *gomock.Controller) *MockWebhookDeliveriesStore {
+	mock := &MockWebhookDeliveriesStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveriesStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveriesStore) EXPECT() *MockWebhookDeliveriesStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookDeliveriesStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Create), arg0)
+}
+
+// CreateIfNotExists mocks base method
+func (m *MockWebhookDeliveriesStore) CreateIfNotExists(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfNotExists", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIfNotExists indicates an expected call of CreateIfNotExists
+func (mr *MockWebhookDeliveriesStoreMockRecorder) CreateIfNotExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfNotExists", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).CreateIfNotExists), arg0)
+}
+
+// FindBySubscription mocks base method
+func (m *MockWebhookDeliveriesStore) FindBySubscription(arg0 types.ID, arg1 store.Pagination) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySubscription", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySubscription indicates an expected call of FindBySubscription
+func (mr *MockWebhookDeliveriesStoreMockRecorder) FindBySubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySubscription", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).FindBySubscription), arg0, arg1)
+}
+
+// FindPending mocks base method
+func (m *MockWebhookDeliveriesStore) FindPending(arg0 time.Time, arg1 int64, arg2 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending
+func (mr *MockWebhookDeliveriesStoreMockRecorder) FindPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).FindPending), arg0, arg1, arg2)
+}
+
+// Save mocks base method
+func (m *MockWebhookDeliveriesStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockWebhookDeliveriesStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Update), arg0)
+}
+
+// MockSystemEventAcksStore is a mock of SystemEventAcksStore interface
+type MockSystemEventAcksStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSystemEventAcksStoreMockRecorder
+}
+
+// MockSystemEventAcksStoreMockRecorder is the mock recorder for MockSystemEventAcksStore
+type MockSystemEventAcksStoreMockRecorder struct {
+	mock *MockSystemEventAcksStore
+}
+
+// NewMockSystemEventAcksStore creates a new mock instance
+func NewMockSystemEventAcksStore(ctrl *gomock.Controller) *MockSystemEventAcksStore {
+	mock := &MockSystemEventAcksStore{ctrl: ctrl}
+	mock.recorder = &MockSystemEventAcksStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSystemEventAcksStore) EXPECT() *MockSystemEventAcksStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockSystemEventAcksStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockSystemEventAcksStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSystemEventAcksStore)(nil).Create), arg0)
+}
+
+// CreateOrUpdate mocks base method
+func (m *MockSystemEventAcksStore) CreateOrUpdate(arg0 *model.SystemEventAck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate
+func (mr *MockSystemEventAcksStoreMockRecorder) CreateOrUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockSystemEventAcksStore)(nil).CreateOrUpdate), arg0)
+}
+
+// Save mocks base method
+func (m *MockSystemEventAcksStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockSystemEventAcksStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSystemEventAcksStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockSystemEventAcksStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockSystemEventAcksStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSystemEventAcksStore)(nil).Update), arg0)
+}
+
+// MockValidatorMutesStore is a mock of ValidatorMutesStore interface
+type MockValidatorMutesStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMutesStoreMockRecorder
+}
+
+// MockValidatorMutesStoreMockRecorder is the mock recorder for MockValidatorMutesStore
+type MockValidatorMutesStoreMockRecorder struct {
+	mock *MockValidatorMutesStore
+}
+
+// NewMockValidatorMutesStore creates a new mock instance
+func NewMockValidatorMutesStore(ctrl *gomock.Controller) *MockValidatorMutesStore {
+	mock := &MockValidatorMutesStore{ctrl: ctrl}
+	mock.recorder = &MockValidatorMutesStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidatorMutesStore) EXPECT() *MockValidatorMutesStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockValidatorMutesStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockValidatorMutesStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockValidatorMutesStore)(nil).Create), arg0)
+}
+
+// Save mocks base method
+func (m *MockValidatorMutesStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockValidatorMutesStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockValidatorMutesStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockValidatorMutesStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockValidatorMutesStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockValidatorMutesStore)(nil).Update), arg0)
+}
+
+// MockBalanceSummaryStore is a mock of BalanceSummaryStore interface
+type MockBalanceSummaryStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceSummaryStoreMockRecorder
+}
+
+// MockBalanceSummaryStoreMockRecorder is the mock recorder for MockBalanceSummaryStore
+type MockBalanceSummaryStoreMockRecorder struct {
+	mock *MockBalanceSummaryStore
+}
+
+// NewMockBalanceSummaryStore creates a new mock instance
+func NewMockBalanceSummaryStore(ctrl *gomock.Controller) *MockBalanceSummaryStore {
+	mock := &MockBalanceSummaryStore{ctrl: ctrl}
+	mock.recorder = &MockBalanceSummaryStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBalanceSummaryStore) EXPECT() *MockBalanceSummaryStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockBalanceSummaryStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockBalanceSummaryStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBalanceSummaryStore)(nil).Create), arg0)
+}
+
+// Find mocks base method
+func (m *MockBalanceSummaryStore) Find(arg0 *model.BalanceSummary) (*model.BalanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", arg0)
+	ret0, _ := ret[0].(*model.BalanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find
+func (mr *MockBalanceSummaryStoreMockRecorder) Find(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBalanceSummaryStore)(nil).Find), arg0)
+}
+
+// FindRewardsByEscrow mocks base method
+func (m *MockBalanceSummaryStore) FindRewardsByEscrow(arg0 types.SummaryInterval, arg1 time.Time, arg2 time.Time) ([]store.EscrowRewardsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRewardsByEscrow", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.EscrowRewardsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRewardsByEscrow indicates an expected call of FindRewardsByEscrow
+func (mr *MockBalanceSummaryStoreMockRecorder) FindRewardsByEscrow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRewardsByEscrow", reflect.TypeOf((*MockBalanceSummaryStore)(nil).FindRewardsByEscrow), arg0, arg1, arg2)
+}
+
+// FindRewardsByEscrowForAddress mocks base method
+func (m *MockBalanceSummaryStore) FindRewardsByEscrowForAddress(arg0 string, arg1 types.SummaryInterval, arg2 time.Time) ([]store.EscrowRewardsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRewardsByEscrowForAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.EscrowRewardsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRewardsByEscrowForAddress indicates an expected call of FindRewardsByEscrowForAddress
+func (mr *MockBalanceSummaryStoreMockRecorder) FindRewardsByEscrowForAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRewardsByEscrowForAddress", reflect.TypeOf((*MockBalanceSummaryStore)(nil).FindRewardsByEscrowForAddress), arg0, arg1, arg2)
+}
+
+// GetSummaries mocks base method
+func (m *MockBalanceSummaryStore) GetSummaries(arg0 string, arg1 types.SummaryInterval, arg2 *types.Time, arg3 *types.Time) ([]model.BalanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummaries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.BalanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummaries indicates an expected call of GetSummaries
+func (mr *MockBalanceSummaryStoreMockRecorder) GetSummaries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummaries", reflect.TypeOf((*MockBalanceSummaryStore)(nil).GetSummaries), arg0, arg1, arg2, arg3)
+}
+
+// Rollup mocks base method
+func (m *MockBalanceSummaryStore) Rollup(arg0 types.SummaryInterval, arg1 types.SummaryInterval, arg2 time.Time, arg3 int64) ([]model.BalanceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.BalanceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockBalanceSummaryStoreMockRecorder) Rollup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockBalanceSummaryStore)(nil).Rollup), arg0, arg1, arg2, arg3)
+}
+
+// Save mocks base method
+func (m *MockBalanceSummaryStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockBalanceSummaryStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBalanceSummaryStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockBalanceSummaryStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockBalanceSummaryStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBalanceSummaryStore)(nil).Update), arg0)
+}
+
+// MockDebondingDelegationsStore is a mock of DebondingDelegationsStore interface
+type MockDebondingDelegationsStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebondingDelegationsStoreMockRecorder
+}
+
+// MockDebondingDelegationsStoreMockRecorder is the mock recorder for MockDebondingDelegationsStore
+type MockDebondingDelegationsStoreMockRecorder struct {
+	mock *MockDebondingDelegationsStore
+}
+
+// NewMockDebondingDelegationsStore creates a new mock instance
+func NewMockDebondingDelegationsStore(ctrl *gomock.Controller) *MockDebondingDelegationsStore {
+	mock := &MockDebondingDelegationsStore{ctrl: ctrl}
+	mock.recorder = &MockDebondingDelegationsStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDebondingDelegationsStore) EXPECT() *MockDebondingDelegationsStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockDebondingDelegationsStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockDebondingDelegationsStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDebondingDelegationsStore)(nil).Create), arg0)
+}
+
+// FindActiveAt mocks base method
+func (m *MockDebondingDelegationsStore) FindActiveAt(arg0 int64) ([]model.DebondingDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveAt", arg0)
+	ret0, _ := ret[0].([]model.DebondingDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveAt indicates an expected call of FindActiveAt
+func (mr *MockDebondingDelegationsStoreMockRecorder) FindActiveAt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveAt", reflect.TypeOf((*MockDebondingDelegationsStore)(nil).FindActiveAt), arg0)
+}
+
+// Revert mocks base method
+func (m *MockDebondingDelegationsStore) Revert(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revert indicates an expected call of Revert
+func (mr *MockDebondingDelegationsStoreMockRecorder) Revert(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockDebondingDelegationsStore)(nil).Revert), arg0)
+}
+
+// Save mocks base method
+func (m *MockDebondingDelegationsStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockDebondingDelegationsStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDebondingDelegationsStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockDebondingDelegationsStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockDebondingDelegationsStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDebondingDelegationsStore)(nil).Update), arg0)
+}
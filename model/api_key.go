@@ -0,0 +1,66 @@
+package model
+
+import (
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/lib/pq"
+)
+
+const (
+	APIKeyScopeRead      APIKeyScope = "read"
+	APIKeyScopeWrite     APIKeyScope = "write"
+	APIKeyScopeBroadcast APIKeyScope = "broadcast"
+)
+
+// APIKeyScope is a group of HTTP API routes client can access
+type APIKeyScope string
+
+func (s APIKeyScope) String() string {
+	return string(s)
+}
+
+func (s APIKeyScope) Valid() bool {
+	return s == APIKeyScopeRead ||
+		s == APIKeyScopeWrite ||
+		s == APIKeyScopeBroadcast
+}
+
+// APIKey authenticates HTTP API client. Only hash of the key is stored
+type APIKey struct {
+	*Model
+
+	Name    string         `json:"name"`
+	KeyHash string         `json:"-"`
+	Scopes  pq.StringArray `json:"scopes"`
+	// RateLimit is the number of requests per minute, default rate limit is used when nil
+	RateLimit *int64      `json:"rate_limit"`
+	RevokedAt *types.Time `json:"revoked_at"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) Valid() bool {
+	if k.Name == "" || k.KeyHash == "" || len(k.Scopes) == 0 {
+		return false
+	}
+	for _, scope := range k.Scopes {
+		if !APIKeyScope(scope).Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
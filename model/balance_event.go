@@ -7,6 +7,9 @@ const (
 	SlashActive    BalanceEventKind = "slash_active"
 	SlashDebonding BalanceEventKind = "slash_debonding"
 	Reward         BalanceEventKind = "reward"
+
+	EscrowPoolActive    = "active"
+	EscrowPoolDebonding = "debonding"
 )
 
 type BalanceEventKind string
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+// DebondingDelegation is a debonding delegation tracked from the height it appeared in staking state
+// until the height it was removed from it
+type DebondingDelegation struct {
+	*Model
+
+	ValidatorUID string         `json:"validator_uid"`
+	DelegatorUID string         `json:"delegator_uid"`
+	Shares       types.Quantity `json:"shares"`
+	DebondEnd    uint64         `json:"debond_end"`
+	// StartHeight is the first height with debonding delegation in staking state
+	StartHeight int64 `json:"start_height"`
+	// EndHeight is the first height without debonding delegation in staking state, nil while it is debonding
+	EndHeight *int64 `json:"end_height"`
+}
+
+func (DebondingDelegation) TableName() string {
+	return "debonding_delegations"
+}
+
+func (d *DebondingDelegation) Valid() bool {
+	return d.ValidatorUID != "" &&
+		d.DelegatorUID != "" &&
+		d.Shares.Valid() &&
+		d.StartHeight > 0
+}
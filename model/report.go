@@ -9,6 +9,7 @@ const (
 	ReportKindIndex ReportKind = iota + 1
 	ReportKindParallelReindex
 	ReportKindSequentialReindex
+	ReportKindPurge
 )
 
 type Report struct {
@@ -20,9 +21,11 @@ type Report struct {
 	EndHeight    int64
 	SuccessCount *int64
 	ErrorCount   *int64
+	SkippedCount *int64
 	ErrorMsg     *string
 	Duration     time.Duration
 	CompletedAt  *types.Time
+	Details      *types.Jsonb
 }
 
 type ReportKind int
@@ -35,6 +38,8 @@ func (k ReportKind) String() string {
 		return "parallel_reindex"
 	case ReportKindSequentialReindex:
 		return "sequential_reindex"
+	case ReportKindPurge:
+		return "purge"
 	default:
 		return "unknown"
 	}
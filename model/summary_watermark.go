@@ -0,0 +1,23 @@
+package model
+
+// SummaryWatermark keeps track of the last source height that was summarized for a given table
+type SummaryWatermark struct {
+	*Model
+
+	Source       string `json:"source"`
+	IndexVersion int64  `json:"index_version"`
+	Height       int64  `json:"height"`
+}
+
+func (SummaryWatermark) TableName() string {
+	return "summary_watermarks"
+}
+
+func (w *SummaryWatermark) Valid() bool {
+	return w.Source != "" &&
+		w.Height >= 0
+}
+
+func (w *SummaryWatermark) Update(m SummaryWatermark) {
+	w.Height = m.Height
+}
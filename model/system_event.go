@@ -13,6 +13,22 @@ const (
 	SystemEventLeftActiveSet              SystemEventKind = "left_active_set"
 	SystemEventMissedNConsecutive         SystemEventKind = "missed_n_consecutive"
 	SystemEventMissedNofM                 SystemEventKind = "missed_n_of_m"
+	SystemEventSlashed                    SystemEventKind = "slashed"
+	SystemEventValidatorRegistered        SystemEventKind = "validator_registered"
+	SystemEventTendermintAddressChanged   SystemEventKind = "tendermint_address_changed"
+	SystemEventNodeChanged                SystemEventKind = "node_changed"
+	SystemEventRecovered                  SystemEventKind = "recovered"
+	SystemEventValidatorSetSizeChanged    SystemEventKind = "validator_set_size_changed"
+	SystemEventTotalActiveEscrowChanged   SystemEventKind = "total_active_escrow_changed"
+	SystemEventBlockTimeDegraded          SystemEventKind = "block_time_degraded"
+	SystemEventCommonPoolChanged          SystemEventKind = "common_pool_changed"
+	SystemEventDelegationAdded            SystemEventKind = "delegation_added"
+	SystemEventReclaimStarted             SystemEventKind = "reclaim_started"
+	SystemEventDebondingCompleted         SystemEventKind = "debonding_completed"
+	SystemEventDailyReward                SystemEventKind = "daily_reward"
+
+	// SystemEventActorNetwork is the actor of system events concerning the whole network
+	SystemEventActorNetwork = "network"
 )
 
 type SystemEventKind string
@@ -31,6 +47,10 @@ type SystemEvent struct {
 	Data   types.Jsonb     `json:"data"`
 }
 
+func (SystemEvent) TableName() string {
+	return "system_events"
+}
+
 func (o SystemEvent) Update(m SystemEvent) {
 	o.Height = m.Height
 	o.Time = m.Time
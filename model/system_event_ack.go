@@ -0,0 +1,21 @@
+package model
+
+import "github.com/figment-networks/oasishub-indexer/types"
+
+// SystemEventAck records that subscriber handled system event
+type SystemEventAck struct {
+	*Model
+
+	SystemEventID types.ID `json:"system_event_id"`
+	Subscriber    string   `json:"subscriber"`
+	Note          *string  `json:"note"`
+}
+
+func (SystemEventAck) TableName() string {
+	return "system_event_acks"
+}
+
+func (a *SystemEventAck) Valid() bool {
+	return a.SystemEventID != 0 &&
+		a.Subscriber != ""
+}
@@ -11,6 +11,7 @@ type ValidatorAgg struct {
 	Address                   string         `json:"address"`
 	EntityUID                 string         `json:"entity_uid"`
 	RecentTendermintAddress   string         `json:"recent_tendermint_address"`
+	RecentNodeID              string         `json:"recent_node_id"`
 	RecentVotingPower         int64          `json:"recent_voting_power"`
 	RecentTotalShares         types.Quantity `json:"recent_total_shares"`
 	RecentActiveEscrowBalance types.Quantity `json:"recent_active_escrow_balance"`
@@ -44,6 +45,7 @@ func (aa *ValidatorAgg) Update(entity ValidatorAgg) {
 	aa.Aggregate.RecentAt = entity.Aggregate.RecentAt
 
 	aa.RecentTendermintAddress = entity.RecentTendermintAddress
+	aa.RecentNodeID = entity.RecentNodeID
 	aa.RecentVotingPower = entity.RecentVotingPower
 	aa.RecentTotalShares = entity.RecentTotalShares
 	aa.RecentActiveEscrowBalance = entity.RecentActiveEscrowBalance
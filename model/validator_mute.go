@@ -0,0 +1,25 @@
+package model
+
+import "github.com/figment-networks/oasishub-indexer/types"
+
+// ValidatorMute hides system events of validator from subscriber within time window,
+// ie. during planned maintenance
+type ValidatorMute struct {
+	*Model
+
+	Address    string     `json:"address"`
+	Subscriber string     `json:"subscriber"`
+	StartTime  types.Time `json:"start_time"`
+	EndTime    types.Time `json:"end_time"`
+	Reason     *string    `json:"reason"`
+}
+
+func (ValidatorMute) TableName() string {
+	return "validator_mutes"
+}
+
+func (m *ValidatorMute) Valid() bool {
+	return m.Address != "" &&
+		m.Subscriber != "" &&
+		m.EndTime.After(m.StartTime.Time)
+}
@@ -14,6 +14,7 @@ type ValidatorSeq struct {
 	Proposed            bool           `json:"proposed"`
 	VotingPower         int64          `json:"voting_power"`
 	TotalShares         types.Quantity `json:"total_shares"`
+	Delegators          int64          `json:"delegators"`
 	ActiveEscrowBalance types.Quantity `json:"active_escrow_balance"`
 	Commission          types.Quantity `json:"commission"`
 	Rewards             types.Quantity `json:"rewards"`
@@ -43,6 +44,7 @@ func (vs *ValidatorSeq) Update(m ValidatorSeq) {
 	vs.Proposed = m.Proposed
 	vs.VotingPower = m.VotingPower
 	vs.TotalShares = m.TotalShares
+	vs.Delegators = m.Delegators
 	vs.ActiveEscrowBalance = m.ActiveEscrowBalance
 	vs.Commission = m.Commission
 	vs.Rewards = m.Rewards
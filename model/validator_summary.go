@@ -19,6 +19,9 @@ type ValidatorSummary struct {
 	CommissionAvg          types.Quantity `json:"commission_avg"`
 	CommissionMax          types.Quantity `json:"commission_max"`
 	CommissionMin          types.Quantity `json:"commission_min"`
+	SharePriceAvg          float64        `json:"share_price_avg"`
+	SharePriceMax          float64        `json:"share_price_max"`
+	SharePriceMin          float64        `json:"share_price_min"`
 	ValidatedSum           int64          `json:"validated_sum"`
 	NotValidatedSum        int64          `json:"not_validated_sum"`
 	ProposedSum            int64          `json:"proposed_sum"`
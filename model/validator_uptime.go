@@ -0,0 +1,108 @@
+package model
+
+// ValidatorUptime tracks precommit results of the most recent validator sequences in a sliding window
+type ValidatorUptime struct {
+	*Model
+
+	Address string `json:"address"`
+	// Height is the height of the most recently recorded validator sequence
+	Height int64 `json:"height"`
+	// Size is the number of validator sequences kept in the window
+	Size int64 `json:"size"`
+	// Count is the number of recorded validator sequences, at most Size
+	Count int64 `json:"count"`
+	// Position is the index of the window bit written next
+	Position int64 `json:"position"`
+	// Missed is a ring buffer with bit set for every missed validator sequence
+	Missed []byte `json:"-"`
+	// MissedInRow is the number of validator sequences missed in a row up to Height
+	MissedInRow int64 `json:"missed_in_row"`
+}
+
+// NewValidatorUptime returns empty uptime window of given size
+func NewValidatorUptime(address string, size int64) *ValidatorUptime {
+	return &ValidatorUptime{
+		Model:   &Model{},
+		Address: address,
+		Size:    size,
+		Missed:  make([]byte, (size+7)/8),
+	}
+}
+
+func (ValidatorUptime) TableName() string {
+	return "validator_uptimes"
+}
+
+func (u *ValidatorUptime) Valid() bool {
+	return u.Address != "" &&
+		u.Size > 0 &&
+		int64(len(u.Missed)) == (u.Size+7)/8
+}
+
+// Record adds precommit result of validator sequence at height to the window
+func (u *ValidatorUptime) Record(height int64, missed bool) {
+	u.setMissed(u.Position, missed)
+	u.Position = (u.Position + 1) % u.Size
+	if u.Count < u.Size {
+		u.Count++
+	}
+
+	if missed {
+		u.MissedInRow++
+	} else {
+		u.MissedInRow = 0
+	}
+	u.Height = height
+}
+
+// MissedTotal returns number of missed validator sequences among n most recent ones
+func (u *ValidatorUptime) MissedTotal(n int64) int64 {
+	var total int64
+	for _, missed := range u.recent(n) {
+		if missed {
+			total++
+		}
+	}
+	return total
+}
+
+// Resize changes size of the window keeping the most recent results
+func (u *ValidatorUptime) Resize(size int64) {
+	if size == u.Size {
+		return
+	}
+
+	recent := u.recent(size)
+
+	u.Size = size
+	u.Missed = make([]byte, (size+7)/8)
+	u.Count = 0
+	u.Position = 0
+	for i := len(recent) - 1; i >= 0; i-- {
+		u.setMissed(u.Position, recent[i])
+		u.Position = (u.Position + 1) % u.Size
+		u.Count++
+	}
+}
+
+// recent returns at most n most recent results starting from the newest one
+func (u *ValidatorUptime) recent(n int64) []bool {
+	if n > u.Count {
+		n = u.Count
+	}
+
+	result := make([]bool, n)
+	for i := int64(0); i < n; i++ {
+		index := (u.Position - 1 - i + u.Size) % u.Size
+		result[i] = u.Missed[index/8]&(1<<uint(index%8)) != 0
+	}
+	return result
+}
+
+func (u *ValidatorUptime) setMissed(index int64, missed bool) {
+	if missed {
+		u.Missed[index/8] |= 1 << uint(index%8)
+	} else {
+		u.Missed[index/8] &^= 1 << uint(index%8)
+	}
+}
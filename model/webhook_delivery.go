@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WebhookDeliveryStatus string
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is the delivery log entry of system event sent to subscription
+type WebhookDelivery struct {
+	*Model
+
+	SubscriptionID types.ID              `json:"subscription_id"`
+	SystemEventID  types.ID              `json:"system_event_id"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int64                 `json:"attempts"`
+	ResponseCode   *int64                `json:"response_code"`
+	ErrorMsg       *string               `json:"error_msg"`
+	NextAttemptAt  types.Time            `json:"next_attempt_at"`
+	DeliveredAt    *types.Time           `json:"delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Succeed marks delivery as delivered
+func (d *WebhookDelivery) Succeed(responseCode int64) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.ResponseCode = &responseCode
+	d.ErrorMsg = nil
+	d.DeliveredAt = types.NewTimeFromTime(time.Now())
+}
+
+// Fail records failed attempt. Delivery is retried at nextAttemptAt or marked as failed when nextAttemptAt is nil
+func (d *WebhookDelivery) Fail(responseCode *int64, err error, nextAttemptAt *time.Time) {
+	d.Attempts++
+	d.ResponseCode = responseCode
+
+	errMsg := err.Error()
+	d.ErrorMsg = &errMsg
+
+	if nextAttemptAt == nil {
+		d.Status = WebhookDeliveryFailed
+		return
+	}
+	d.NextAttemptAt = *types.NewTimeFromTime(*nextAttemptAt)
+}
@@ -0,0 +1,28 @@
+package model
+
+import "github.com/figment-networks/oasishub-indexer/types"
+
+// WebhookSubscription defines URL system events are delivered to
+type WebhookSubscription struct {
+	*Model
+
+	URL    string           `json:"url"`
+	Secret string           `json:"-"`
+	Actor  *string          `json:"actor"`
+	Kind   *SystemEventKind `json:"kind"`
+
+	// APIKeyID is the id of api key which created subscription
+	APIKeyID *types.ID `json:"-"`
+
+	// QueuedUntil is the creation time of system events queued for delivery so far
+	QueuedUntil types.Time `json:"-"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s *WebhookSubscription) Valid() bool {
+	return s.URL != "" &&
+		s.Secret != ""
+}
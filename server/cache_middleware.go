@@ -0,0 +1,186 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/utils/cache"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxCacheControlAge bounds max-age sent to clients, so reindexed heights are picked up eventually
+	maxCacheControlAge = time.Hour
+
+	// cacheVary lists request headers responses depend on. Routes require API key, so responses must
+	// not be shared between clients with different credentials
+	cacheVary = "X-API-Key, Authorization"
+
+	cacheStatusHeader = "X-Cache"
+	cacheStatusHit    = "HIT"
+	cacheStatusMiss   = "MISS"
+)
+
+var serverCacheRequests = metrics.MustNewCounterWithTags(metrics.Options{
+	Namespace: "indexer",
+	Subsystem: "oasis_http",
+	Name:      "cache_requests",
+	Desc:      "The total number of cacheable requests by cache status",
+	Tags:      []string{"status"},
+})
+
+// cachedResponse is stored in cache for successful responses
+type cachedResponse struct {
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// CacheMiddleware caches successful responses of height based queries.
+// Responses for explicit height never change once height is indexed, so they are cached for finalizedTTL.
+// Responses for the most recent height are cached for latestTTL. Clients may only keep responses in private caches
+// for at most maxCacheControlAge
+func CacheMiddleware(c cache.Cache, latestTTL, finalizedTTL time.Duration) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := cacheKey(ctx.Request)
+
+		ttl := latestTTL
+		if isFinalizedHeightRequest(ctx.Request) {
+			ttl = finalizedTTL
+		}
+		cacheControl := cacheControlFor(ttl)
+
+		value, ok, err := c.Get(ctx.Request.Context(), key)
+		if err != nil {
+			logger.Error(err)
+		}
+		if ok {
+			var resp cachedResponse
+			if err := json.Unmarshal(value, &resp); err == nil {
+				serverCacheRequests.WithLabels(cacheStatusHit).Inc()
+				ctx.Header(cacheStatusHeader, cacheStatusHit)
+				writeCachedResponse(ctx, ctx.Writer, resp, cacheControl)
+				ctx.Abort()
+				return
+			}
+		}
+
+		serverCacheRequests.WithLabels(cacheStatusMiss).Inc()
+
+		writer := ctx.Writer
+		recorder := &responseRecorder{ResponseWriter: writer, status: http.StatusOK}
+		ctx.Writer = recorder
+		ctx.Next()
+		ctx.Writer = writer
+
+		if recorder.status != http.StatusOK {
+			writer.WriteHeader(recorder.status)
+			writer.Write(recorder.body.Bytes())
+			return
+		}
+
+		resp := cachedResponse{
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if value, err := json.Marshal(resp); err == nil {
+			if err := c.Set(ctx.Request.Context(), key, value, ttl); err != nil {
+				logger.Error(err)
+			}
+		}
+
+		ctx.Header(cacheStatusHeader, cacheStatusMiss)
+		writeCachedResponse(ctx, writer, resp, cacheControl)
+	}
+}
+
+// writeCachedResponse writes response with cache headers or not modified status when ETag matches
+func writeCachedResponse(ctx *gin.Context, w gin.ResponseWriter, resp cachedResponse, cacheControl string) {
+	etag := etagOf(resp.Body)
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("Vary", cacheVary)
+
+	if ctx.GetHeader("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		w.WriteHeaderNow()
+		return
+	}
+
+	if resp.ContentType != "" {
+		w.Header().Set("Content-Type", resp.ContentType)
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp.Body)
+}
+
+// cacheControlFor returns private Cache-Control header value with max-age of ttl bounded by maxCacheControlAge
+func cacheControlFor(ttl time.Duration) string {
+	if ttl > maxCacheControlAge {
+		ttl = maxCacheControlAge
+	}
+	return fmt.Sprintf("private, max-age=%d", int64(ttl.Seconds()))
+}
+
+// cacheKey returns key of request path and sorted query params
+func cacheKey(r *http.Request) string {
+	return "http:" + r.URL.Path + "?" + r.URL.Query().Encode()
+}
+
+// isFinalizedHeightRequest returns true when request asks for explicit height instead of the most recent one
+func isFinalizedHeightRequest(r *http.Request) bool {
+	height, err := strconv.ParseInt(r.URL.Query().Get("height"), 10, 64)
+	return err == nil && height > 0
+}
+
+func etagOf(body []byte) string {
+	sum := sha1.Sum(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// responseRecorder buffers response, so it can be cached before it is written
+type responseRecorder struct {
+	gin.ResponseWriter
+
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *responseRecorder) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *responseRecorder) Status() int {
+	return w.status
+}
+
+func (w *responseRecorder) Size() int {
+	return w.body.Len()
+}
+
+func (w *responseRecorder) Written() bool {
+	return w.written
+}
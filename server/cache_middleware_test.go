@@ -0,0 +1,97 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/utils/cache"
+	"github.com/gin-gonic/gin"
+)
+
+func TestCacheMiddleware(t *testing.T) {
+	calls := 0
+	engine := gin.New()
+	engine.GET("/block", CacheMiddleware(cache.NewLRU(10), 5*time.Second, time.Hour), func(c *gin.Context) {
+		calls++
+		if c.Query("height") == "999" {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "height is not indexed yet"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"height": c.Query("height")})
+	})
+
+	request := func(url string, header http.Header) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		for key := range header {
+			req.Header.Set(key, header.Get(key))
+		}
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("caches finalized height", func(t *testing.T) {
+		calls = 0
+
+		first := request("/block?height=10", nil)
+		second := request("/block?height=10", nil)
+
+		if calls != 1 {
+			t.Errorf("unexpected handler calls, want %v; got %v", 1, calls)
+		}
+		if first.Header().Get(cacheStatusHeader) != cacheStatusMiss || second.Header().Get(cacheStatusHeader) != cacheStatusHit {
+			t.Errorf("unexpected cache statuses: %v, %v", first.Header().Get(cacheStatusHeader), second.Header().Get(cacheStatusHeader))
+		}
+		if second.Body.String() != first.Body.String() {
+			t.Errorf("unexpected cached body: %v", second.Body.String())
+		}
+		if second.Header().Get("Content-Type") != first.Header().Get("Content-Type") {
+			t.Errorf("unexpected cached content type: %v", second.Header().Get("Content-Type"))
+		}
+		if second.Header().Get("Cache-Control") != "private, max-age=3600" {
+			t.Errorf("unexpected cache control: %v", second.Header().Get("Cache-Control"))
+		}
+		if second.Header().Get("Vary") != "X-API-Key, Authorization" {
+			t.Errorf("unexpected vary: %v", second.Header().Get("Vary"))
+		}
+	})
+
+	t.Run("uses short max age for latest height", func(t *testing.T) {
+		w := request("/block", nil)
+
+		if w.Header().Get("Cache-Control") != "private, max-age=5" {
+			t.Errorf("unexpected cache control: %v", w.Header().Get("Cache-Control"))
+		}
+	})
+
+	t.Run("responds not modified for matching etag", func(t *testing.T) {
+		etag := request("/block?height=11", nil).Header().Get("ETag")
+		w := request("/block?height=11", http.Header{"If-None-Match": []string{etag}})
+
+		if w.Code != http.StatusNotModified {
+			t.Errorf("unexpected status, want %v; got %v", http.StatusNotModified, w.Code)
+		}
+		if w.Body.Len() != 0 {
+			t.Errorf("unexpected body: %v", w.Body.String())
+		}
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		calls = 0
+
+		request("/block?height=999", nil)
+		w := request("/block?height=999", nil)
+
+		if calls != 2 {
+			t.Errorf("unexpected handler calls, want %v; got %v", 2, calls)
+		}
+		if w.Code != http.StatusNotFound {
+			t.Errorf("unexpected status, want %v; got %v", http.StatusNotFound, w.Code)
+		}
+		if w.Header().Get("Cache-Control") != "" {
+			t.Errorf("unexpected cache control: %v", w.Header().Get("Cache-Control"))
+		}
+	})
+}
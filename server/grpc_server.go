@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/grpc/indexer/indexerpb"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/usecase"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// GrpcServer handles gRPC requests
+type GrpcServer struct {
+	cfg      *config.Config
+	handlers *usecase.GrpcHandlers
+
+	server *grpc.Server
+}
+
+// NewGrpc returns a new gRPC server instance
+func NewGrpc(cfg *config.Config, handlers *usecase.GrpcHandlers) *GrpcServer {
+	// Indexer service has only queries, so every call requires read scope
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(handlers.Auth.Unary(model.APIKeyScopeRead)),
+		grpc.StreamInterceptor(handlers.Auth.Stream(model.APIKeyScopeRead)),
+	)
+
+	app := &GrpcServer{
+		cfg:      cfg,
+		handlers: handlers,
+		server:   server,
+	}
+	return app.init()
+}
+
+// Start starts the gRPC server
+func (s *GrpcServer) Start(listenAddr string) error {
+	logger.Info("starting grpc server...", logger.Field("app", "grpc-server"))
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	return s.server.Serve(lis)
+}
+
+// init registers services of the gRPC server
+func (s *GrpcServer) init() *GrpcServer {
+	logger.Info("initializing grpc server...", logger.Field("app", "grpc-server"))
+
+	indexerpb.RegisterIndexerServiceServer(s.server, s.handlers.Indexer)
+
+	// Reflection allows clients like grpcurl to discover services
+	reflection.Register(s.server)
+
+	return s
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	setup()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func setup() {
+	gin.SetMode(gin.TestMode)
+	logger.InitTest()
+}
@@ -1,7 +1,11 @@
 package server
 
 import (
+	"fmt"
+
 	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
 	"github.com/figment-networks/oasishub-indexer/utils/reporting"
 	"github.com/gin-gonic/gin"
 )
@@ -35,3 +39,38 @@ func ErrorReportingMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// VersionMiddleware marks requests as routed to given version of API, so responses are rendered in envelope
+func VersionMiddleware(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		http.SetAPIVersion(c, version)
+		c.Next()
+	}
+}
+
+// DeprecationMiddleware marks responses of unversioned routes as deprecated and links to their versioned successor
+func DeprecationMiddleware(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Deprecation", "true")
+		c.Header("Link", fmt.Sprintf(`</%s%s>; rel="successor-version"`, version, c.Request.URL.Path))
+		c.Next()
+	}
+}
+
+// cacheMiddleware returns response cache middleware or pass through middleware when caching is disabled
+func (s *Server) cacheMiddleware() gin.HandlerFunc {
+	if s.cache == nil {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	latestTTL, finalizedTTL, err := s.cfg.CacheTTLs()
+	if err != nil {
+		logger.Error(err)
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	return CacheMiddleware(s.cache, latestTTL, finalizedTTL)
+}
@@ -1,27 +1,65 @@
 package server
 
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
 // setupRoutes sets up routes for gin application
 func (s *Server) setupRoutes() {
-	// Queries
+	// Unversioned
 	s.engine.GET("/health", s.handlers.Health.Handle)
-	s.engine.GET("/status", s.handlers.GetStatus.Handle)
-	s.engine.GET("/block", s.handlers.GetBlockByHeight.Handle)
-	s.engine.GET("/block_times/:limit", s.handlers.GetBlockTimes.Handle)
-	s.engine.GET("/blocks_summary", s.handlers.GetBlockSummary.Handle)
-	s.engine.GET("/transactions", s.handlers.GetTransactionsByHeight.Handle)
-	s.engine.GET("/validator/:address", s.handlers.GetValidatorByAddress.Handle)
-	s.engine.GET("/validators/for_min_height/:height", s.handlers.GetValidatorsForMinHeight.Handle)
-	s.engine.GET("/validators", s.handlers.GetValidatorsByHeight.Handle)
-	s.engine.GET("/validators_summary", s.handlers.GetValidatorSummary.Handle)
-	s.engine.GET("/staking", s.handlers.GetStakingDetailsByHeight.Handle)
-	s.engine.GET("/delegations", s.handlers.GetDelegationsByHeight.Handle)
-	s.engine.GET("/delegations/:address", s.handlers.GetDelegationsByAddress.Handle)
-	s.engine.GET("/debonding_delegations", s.handlers.GetDebondingDelegationsByHeight.Handle)
-	s.engine.GET("/debonding_delegations/:address", s.handlers.GetDebondingDelegationsByAddress.Handle)
-	s.engine.GET("/account/:address", s.handlers.GetAccountByAddress.Handle)
-	s.engine.GET("/system_events/:address", s.handlers.GetSystemEventsForAddress.Handle)
-	s.engine.GET("/balance/:address", s.handlers.GetBalanceForAddress.Handle)
+	s.engine.GET("/stream", s.handlers.Auth.Require(model.APIKeyScopeRead), s.handlers.Stream.Handle)
+	s.engine.POST("/graphql", s.handlers.Auth.Require(model.APIKeyScopeRead), s.handlers.ExecuteGraphQL.Handle)
+	s.engine.GET("/openapi.json", s.handlers.GetOpenAPISpec.Handle)
+	s.engine.GET("/docs", s.handlers.GetSwaggerUI.Handle)
+
+	cached := s.cacheMiddleware()
+
+	s.setupResourceRoutes(s.engine.Group("/"+http.APIVersion1, VersionMiddleware(http.APIVersion1)), cached)
+
+	// Deprecated aliases of versioned routes
+	s.setupResourceRoutes(s.engine.Group("", DeprecationMiddleware(http.APIVersion1)), cached)
+}
+
+// setupResourceRoutes sets up routes of versioned API. Height based queries are wrapped by cached middleware.
+// Every route requires api key scope, unless anonymous access with the scope is enabled
+func (s *Server) setupResourceRoutes(g *gin.RouterGroup, cached gin.HandlerFunc) {
+	// Queries
+	r := g.Group("", s.handlers.Auth.Require(model.APIKeyScopeRead))
+	r.GET("/status", s.handlers.GetStatus.Handle)
+	r.GET("/block", cached, s.handlers.GetBlockByHeight.Handle)
+	r.GET("/block_times/:limit", s.handlers.GetBlockTimes.Handle)
+	r.GET("/blocks_summary", s.handlers.GetBlockSummary.Handle)
+	r.GET("/transactions", cached, s.handlers.GetTransactionsByHeight.Handle)
+	r.GET("/validator/:address", s.handlers.GetValidatorByAddress.Handle)
+	r.GET("/validator/:address/returns", s.handlers.GetValidatorReturns.Handle)
+	r.GET("/validator/:address/share_price", s.handlers.GetValidatorSharePrice.Handle)
+	r.GET("/validators/for_min_height/:height", s.handlers.GetValidatorsForMinHeight.Handle)
+	r.GET("/validators/leaderboard", s.handlers.GetValidatorLeaderboard.Handle)
+	r.GET("/validators", cached, s.handlers.GetValidatorsByHeight.Handle)
+	r.GET("/validators_summary", s.handlers.GetValidatorSummary.Handle)
+	r.GET("/staking", cached, s.handlers.GetStakingDetailsByHeight.Handle)
+	r.GET("/delegations", s.handlers.GetDelegationsByHeight.Handle)
+	r.GET("/delegations/:address", s.handlers.GetDelegationsByAddress.Handle)
+	r.GET("/debonding_delegations", s.handlers.GetDebondingDelegationsByHeight.Handle)
+	r.GET("/debonding_delegations/:address", s.handlers.GetDebondingDelegationsByAddress.Handle)
+	r.GET("/account/:address", s.handlers.GetAccountByAddress.Handle)
+	r.GET("/system_events", s.handlers.GetSystemEventsForNetwork.Handle)
+	r.GET("/system_events/:address", s.handlers.GetSystemEventsForAddress.Handle)
+	r.GET("/balance/:address", s.handlers.GetBalanceForAddress.Handle)
+	r.GET("/portfolio/:address", s.handlers.GetPortfolioByAddress.Handle)
 
 	// Commands
-	s.engine.POST("/transactions", s.handlers.BroadcastTransaction.Handle)
+	b := g.Group("", s.handlers.Auth.Require(model.APIKeyScopeBroadcast))
+	b.POST("/transactions", s.handlers.BroadcastTransaction.Handle)
+
+	w := g.Group("", s.handlers.Auth.Require(model.APIKeyScopeWrite))
+	w.GET("/webhook_subscriptions", s.handlers.GetWebhookSubscriptions.Handle)
+	w.POST("/webhook_subscriptions", s.handlers.CreateWebhookSubscription.Handle)
+	w.DELETE("/webhook_subscriptions/:id", s.handlers.DeleteWebhookSubscription.Handle)
+	w.GET("/webhook_subscriptions/:id/deliveries", s.handlers.GetWebhookDeliveries.Handle)
+	w.POST("/system_events/:id/ack", s.handlers.AcknowledgeSystemEvent.Handle)
+	w.POST("/validators/:address/mute", s.handlers.MuteValidator.Handle)
 }
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/usecase"
+	"github.com/figment-networks/oasishub-indexer/usecase/openapi"
+)
+
+func TestRoutes_OpenAPI(t *testing.T) {
+	cfg := &config.Config{}
+	s := New(cfg, usecase.NewHttpHandlers(cfg, nil, nil), nil)
+
+	documented := map[string]bool{}
+	for _, op := range openapi.Operations {
+		documented[op.Method+" "+op.Path] = true
+	}
+
+	registered := map[string]bool{}
+	for _, route := range s.engine.Routes() {
+		key := route.Method + " " + route.Path
+		registered[key] = true
+
+		if !documented[key] {
+			t.Errorf("route %s has no entry in openapi.Operations", key)
+		}
+	}
+
+	for key := range documented {
+		if !registered[key] {
+			t.Errorf("openapi.Operations entry %s has no route", key)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/figment-networks/indexing-engine/metrics/prometheusmetrics"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/usecase"
+	"github.com/figment-networks/oasishub-indexer/utils/cache"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
 	"github.com/gin-gonic/gin"
 )
@@ -13,17 +14,21 @@ import (
 type Server struct {
 	cfg      *config.Config
 	handlers *usecase.HttpHandlers
+	cache    cache.Cache
 
 	engine *gin.Engine
 }
 
-// New returns a new server instance
-func New(cfg *config.Config, handlers *usecase.HttpHandlers) *Server {
+// New returns a new server instance. Responses of height based queries are not cached when responseCache is nil
+func New(cfg *config.Config, handlers *usecase.HttpHandlers, responseCache cache.Cache) *Server {
 	app := &Server{
 		cfg:      cfg,
 		engine:   gin.Default(),
 		handlers: handlers,
+		cache:    responseCache,
 	}
+	// Forwarding headers can be set by clients, so they are trusted only when server is behind proxy
+	app.engine.ForwardedByClientIP = cfg.ApiForwardedByClientIP
 	return app.init()
 }
 
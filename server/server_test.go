@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func TestNew_ForwardedByClientIP(t *testing.T) {
+	tests := []struct {
+		description string
+		forwarded   bool
+		expectedIP  string
+	}{
+		{"ignores forwarding headers by default", false, "192.0.2.1"},
+		{"uses forwarding headers when enabled", true, "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cfg := &config.Config{ApiForwardedByClientIP: tt.forwarded}
+			s := New(cfg, usecase.NewHttpHandlers(cfg, nil, nil), nil)
+
+			var clientIP string
+			s.engine.GET("/test_client_ip", func(c *gin.Context) {
+				clientIP = c.ClientIP()
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test_client_ip", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("X-Forwarded-For", "203.0.113.7")
+			s.engine.ServeHTTP(httptest.NewRecorder(), req)
+
+			if clientIP != tt.expectedIP {
+				t.Errorf("unexpected client ip, want %s; got %s", tt.expectedIP, clientIP)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ type AccountAggStore interface {
 
 	FindBy(string, interface{}) (*model.AccountAgg, error)
 	FindByPublicKey(string) (*model.AccountAgg, error)
+	FindByPublicKeys([]string) ([]model.AccountAgg, error)
 }
 
 func NewAccountAggStore(db *gorm.DB) *accountAggStore {
@@ -36,3 +37,18 @@ func (s accountAggStore) FindBy(key string, value interface{}) (*model.AccountAg
 func (s accountAggStore) FindByPublicKey(key string) (*model.AccountAgg, error) {
 	return s.FindBy("public_key", key)
 }
+
+// FindByPublicKeys returns accounts for the public keys
+func (s accountAggStore) FindByPublicKeys(keys []string) ([]model.AccountAgg, error) {
+	var result []model.AccountAgg
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	err := s.db.
+		Where("public_key IN (?)", keys).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
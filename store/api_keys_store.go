@@ -0,0 +1,40 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ APIKeysStore = (*apiKeysStore)(nil)
+)
+
+type APIKeysStore interface {
+	BaseStore
+
+	FindByKeyHash(string) (*model.APIKey, error)
+	FindByName(string) (*model.APIKey, error)
+}
+
+func NewAPIKeysStore(db *gorm.DB) *apiKeysStore {
+	return &apiKeysStore{scoped(db, model.APIKey{})}
+}
+
+// apiKeysStore handles operations on api keys
+type apiKeysStore struct {
+	baseStore
+}
+
+// FindByKeyHash returns api key by hash of the key
+func (s apiKeysStore) FindByKeyHash(keyHash string) (*model.APIKey, error) {
+	result := &model.APIKey{}
+	err := findBy(s.db, result, "key_hash", keyHash)
+	return result, checkErr(err)
+}
+
+// FindByName returns api key by name
+func (s apiKeysStore) FindByName(name string) (*model.APIKey, error) {
+	result := &model.APIKey{}
+	err := findBy(s.db, result, "name", name)
+	return result, checkErr(err)
+}
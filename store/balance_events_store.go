@@ -18,8 +18,10 @@ type BalanceEventsStore interface {
 
 	GetLastEventTime() (types.Time, error)
 	CreateOrUpdate(*model.BalanceEvent) error
-	DeleteOlderThan(time.Time) (*int64, error)
-	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]model.BalanceSummary, error)
+	FindChangesSince(int64) (*ChangeSetRow, error)
+	Summarize(types.SummaryInterval, time.Time) ([]model.BalanceSummary, error)
+	FindRewardTotals(time.Time, time.Time) ([]RewardTotalRow, error)
+	FindLastByAddresses([]string, int64) ([]model.BalanceEvent, error)
 }
 
 func NewBalanceEventsStore(db *gorm.DB) *balanceEventsStore {
@@ -45,22 +47,6 @@ func (s *balanceEventsStore) CreateOrUpdate(val *model.BalanceEvent) error {
 	return s.Save(existing)
 }
 
-// DeleteOlderThan deletes balance events older than given threshold
-func (s *balanceEventsStore) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
-	query := s.db.Table("syncables").Select("height").Where("time < ?", purgeThreshold).QueryExpr()
-
-	tx := s.db.
-		Unscoped().
-		Where("height IN (?)", query).
-		Delete(&model.BalanceEvent{})
-
-	if tx.Error != nil {
-		return nil, checkErr(tx.Error)
-	}
-
-	return &tx.RowsAffected, nil
-}
-
 // GetLastEventTime returns the time corresponding to the most recent balance event
 func (s *balanceEventsStore) GetLastEventTime() (types.Time, error) {
 	var result struct {
@@ -78,38 +64,68 @@ func (s *balanceEventsStore) GetLastEventTime() (types.Time, error) {
 	return result.Time, checkErr(err)
 }
 
-// Summarize gets the summarized version of balance events
-func (s *balanceEventsStore) Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]model.BalanceSummary, error) {
+// FindChangesSince finds balance events created after given height
+func (s *balanceEventsStore) FindChangesSince(height int64) (*ChangeSetRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceEventStore_FindChangesSince"))
+	defer t.ObserveDuration()
+
+	return findChangesSince(s.db, balanceEventsChangesSinceQuery, height)
+}
+
+// Summarize gets the summarized version of balance events for buckets starting from given time
+func (s *balanceEventsStore) Summarize(interval types.SummaryInterval, since time.Time) ([]model.BalanceSummary, error) {
 	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceEventStore_Summarize"))
 	defer t.ObserveDuration()
 
-	tx := s.db.
+	var models []model.BalanceSummary
+	err := s.db.
 		Table(model.BalanceEvent{}.TableName()).
 		Select(summarizeBalanceQuerySelect).
-		Joins(summarizeBalanceJoinQuery, interval).
-		Group("s.time_bucket, balance_events.address, balance_events.escrow_address, s.start_height")
-
-	if len(activityPeriods) == 1 {
-		activityPeriod := activityPeriods[0]
-		tx = tx.Or("time_bucket < ? OR time_bucket >= ?", activityPeriod.Min, activityPeriod.Max)
-	} else {
-		for i, activityPeriod := range activityPeriods {
-			isLast := i == len(activityPeriods)-1
-
-			if isLast {
-				tx = tx.Or("time_bucket >= ?", activityPeriod.Max)
-			} else {
-				duration, err := interval.ToDuration()
-				if err != nil {
-					return nil, err
-				}
-				tx = tx.Or("time_bucket >= ? AND time_bucket < ?", activityPeriod.Max.Add(duration), activityPeriods[i+1].Min)
-			}
-		}
+		Joins(summarizeBalanceJoinQuery, interval, interval, since).
+		Group("s.time_bucket, balance_events.address, balance_events.escrow_address, s.start_height").
+		Find(&models).
+		Error
+
+	return models, err
+}
+
+// RewardTotalRow contains rewards received by address
+type RewardTotalRow struct {
+	Address string         `json:"address"`
+	Amount  types.Quantity `json:"amount"`
+}
+
+// FindRewardTotals returns rewards summed per address for heights with time within [start, end)
+func (s *balanceEventsStore) FindRewardTotals(start time.Time, end time.Time) ([]RewardTotalRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceEventStore_FindRewardTotals"))
+	defer t.ObserveDuration()
+
+	var result []RewardTotalRow
+	err := s.db.
+		Table(model.BalanceEvent{}.TableName()).
+		Select("balance_events.address, SUM(balance_events.amount) AS amount").
+		Joins("INNER JOIN syncables AS s ON balance_events.height = s.height").
+		Where("balance_events.kind = ? AND s.time >= ? AND s.time < ?", model.Reward, start, end).
+		Group("balance_events.address").
+		Order("balance_events.address").
+		Scan(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindLastByAddresses returns up to limit most recent balance events for every address
+func (s *balanceEventsStore) FindLastByAddresses(addresses []string, limit int64) ([]model.BalanceEvent, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceEventStore_FindLastByAddresses"))
+	defer t.ObserveDuration()
+
+	var result []model.BalanceEvent
+	if len(addresses) == 0 {
+		return result, nil
 	}
 
-	var models []model.BalanceSummary
-	return models, tx.Find(&models).Error
+	err := findLastByKeys(s.db, &result, model.BalanceEvent{}.TableName(), "address", addresses, limit, "")
+	return result, checkErr(err)
 }
 
 func (s *balanceEventsStore) findUnique(height int64, escrowAddress, address string, kind model.BalanceEventKind) (*model.BalanceEvent, error) {
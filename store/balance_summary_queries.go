@@ -17,6 +17,52 @@ const (
 	  MIN(height)         AS start_height,
 	  DATE_TRUNC(?, time) AS time_bucket
 	FROM syncables
+	WHERE time >= DATE_TRUNC(?, ?::TIMESTAMPTZ)
 	GROUP BY time_bucket
  ) AS s ON balance_events.height >= s.start_height AND balance_events.height <= s.end_height`
+
+	balanceEventsChangesSinceQuery = `
+SELECT
+  MIN(s.time)                AS min_time,
+  MAX(balance_events.height) AS max_height
+FROM balance_events
+INNER JOIN syncables AS s ON balance_events.height = s.height
+WHERE balance_events.height > ?
+HAVING COUNT(*) > 0
+`
+
+	rewardsByEscrowQuery = `
+SELECT
+  escrow_address,
+  SUM(total_rewards)    AS total_rewards,
+  SUM(total_commission) AS total_commission
+FROM balance_summary
+WHERE time_interval = ? AND time_bucket >= ? AND time_bucket < ?
+GROUP BY escrow_address
+`
+
+	rewardsByEscrowForAddressQuery = `
+SELECT
+  escrow_address,
+  SUM(total_rewards)    AS total_rewards,
+  SUM(total_commission) AS total_commission
+FROM balance_summary
+WHERE address = ? AND time_interval = ? AND time_bucket >= ?
+GROUP BY escrow_address
+`
+
+	rollupBalanceSummaryQuery = `
+SELECT
+  DATE_TRUNC(?, time_bucket) AS time_bucket,
+  MIN(start_height)          AS start_height,
+  address,
+  escrow_address,
+  SUM(total_rewards)         AS total_rewards,
+  SUM(total_commission)      AS total_commission,
+  SUM(total_slashed)         AS total_slashed
+FROM balance_summary
+WHERE time_interval = ? AND index_version = ? AND time_bucket >= DATE_TRUNC(?, ?::TIMESTAMPTZ)
+GROUP BY 1, address, escrow_address
+ORDER BY 1
+`
 )
@@ -1,7 +1,7 @@
 package store
 
 import (
-	"fmt"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/figment-networks/oasishub-indexer/model"
@@ -17,8 +17,10 @@ type BalanceSummaryStore interface {
 	BaseStore
 
 	Find(*model.BalanceSummary) (*model.BalanceSummary, error)
-	GetDailySummaries(address string, start, end *types.Time) ([]model.BalanceSummary, error)
-	FindActivityPeriods(types.SummaryInterval, int64) ([]ActivityPeriodRow, error)
+	GetSummaries(address string, interval types.SummaryInterval, start, end *types.Time) ([]model.BalanceSummary, error)
+	FindRewardsByEscrow(types.SummaryInterval, time.Time, time.Time) ([]EscrowRewardsRow, error)
+	FindRewardsByEscrowForAddress(string, types.SummaryInterval, time.Time) ([]EscrowRewardsRow, error)
+	Rollup(types.SummaryInterval, types.SummaryInterval, time.Time, int64) ([]model.BalanceSummary, error)
 }
 
 func NewBalanceSummaryStore(db *gorm.DB) *balanceSummaryStore {
@@ -41,26 +43,15 @@ func (s balanceSummaryStore) Find(query *model.BalanceSummary) (*model.BalanceSu
 	return &result, checkErr(err)
 }
 
-// FindActivityPeriods Finds activity periods
-func (s *balanceSummaryStore) FindActivityPeriods(interval types.SummaryInterval, indexVersion int64) ([]ActivityPeriodRow, error) {
-	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_FindActivityPeriods"))
-	defer t.ObserveDuration()
-
-	query := getActivityPeriodsQuery(model.BalanceSummary{}.TableName())
-
-	var res []ActivityPeriodRow
-	return res, s.db.Raw(query, fmt.Sprintf("1%s", interval), interval, indexVersion).Find(&res).Error
-}
-
-// GetDailySummaries Gets daily summary of balance events
-func (s *balanceSummaryStore) GetDailySummaries(address string, start, end *types.Time) ([]model.BalanceSummary, error) {
-	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_GetDailySummaries"))
+// GetSummaries Gets summary of balance events for given interval
+func (s *balanceSummaryStore) GetSummaries(address string, interval types.SummaryInterval, start, end *types.Time) ([]model.BalanceSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_GetSummaries"))
 	defer t.ObserveDuration()
 
 	tx := s.db.
 		Table(model.BalanceSummary{}.TableName()).
 		Select("*").
-		Where("address = ? AND time_interval='day'", address).
+		Where("address = ? AND time_interval = ?", address, interval).
 		Order("time_bucket")
 
 	if !end.IsZero() {
@@ -73,3 +64,36 @@ func (s *balanceSummaryStore) GetDailySummaries(address string, start, end *type
 	var res []model.BalanceSummary
 	return res, tx.Find(&res).Error
 }
+
+type EscrowRewardsRow struct {
+	EscrowAddress   string         `json:"escrow_address"`
+	TotalRewards    types.Quantity `json:"total_rewards"`
+	TotalCommission types.Quantity `json:"total_commission"`
+}
+
+// FindRewardsByEscrow gets rewards and commission of escrow accounts in summaries with time buckets in [start, end)
+func (s *balanceSummaryStore) FindRewardsByEscrow(interval types.SummaryInterval, start, end time.Time) ([]EscrowRewardsRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_FindRewardsByEscrow"))
+	defer t.ObserveDuration()
+
+	var res []EscrowRewardsRow
+	return res, s.db.Raw(rewardsByEscrowQuery, interval, start, end).Find(&res).Error
+}
+
+// FindRewardsByEscrowForAddress gets rewards and commission of address per escrow account in summaries with time buckets since given time
+func (s *balanceSummaryStore) FindRewardsByEscrowForAddress(address string, interval types.SummaryInterval, since time.Time) ([]EscrowRewardsRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_FindRewardsByEscrowForAddress"))
+	defer t.ObserveDuration()
+
+	var res []EscrowRewardsRow
+	return res, s.db.Raw(rewardsByEscrowForAddressQuery, address, interval, since).Find(&res).Error
+}
+
+// Rollup aggregates balance summaries of one interval into buckets of a larger interval starting from given time
+func (s *balanceSummaryStore) Rollup(from, to types.SummaryInterval, since time.Time, indexVersion int64) ([]model.BalanceSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BalanceSummaryStore_Rollup"))
+	defer t.ObserveDuration()
+
+	var res []model.BalanceSummary
+	return res, s.db.Raw(rollupBalanceSummaryQuery, to, from, indexVersion, to, since).Find(&res).Error
+}
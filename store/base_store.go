@@ -3,11 +3,14 @@ package store
 import (
 	"errors"
 	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
 var (
-	ErrNotFound = errors.New("record not found")
+	ErrNotFound         = errors.New("record not found")
+	ErrHeightNotIndexed = errors.New("height is not indexed yet")
 
 	_ BaseStore = (*baseStore)(nil)
 )
@@ -64,6 +67,29 @@ func findMostRecent(db *gorm.DB, orderField string, record interface{}) error {
 		Error
 }
 
+// ChangeSetRow describes source records added after given height
+type ChangeSetRow struct {
+	MinTime   types.Time
+	MaxHeight int64
+}
+
+func findChangesSince(db *gorm.DB, query string, height int64) (*ChangeSetRow, error) {
+	var result ChangeSetRow
+	err := db.Raw(query, height).Scan(&result).Error
+	return &result, checkErr(err)
+}
+
+// findLastByKeys returns up to limit most recent records for every key of given column.
+// Filter is appended to the conditions of the query and its args are placed before the limit
+func findLastByKeys(db *gorm.DB, dst interface{}, table string, column string, keys []string, limit int64, filter string, filterArgs ...interface{}) error {
+	query := fmt.Sprintf(lastByKeysQuery, table, column, filter)
+
+	args := append([]interface{}{keys}, filterArgs...)
+	args = append(args, limit)
+
+	return db.Raw(query, args...).Scan(dst).Error
+}
+
 func checkErr(err error) error {
 	if gorm.IsRecordNotFoundError(err) {
 		return ErrNotFound
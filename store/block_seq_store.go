@@ -5,7 +5,6 @@ import (
 
 	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/figment-networks/oasishub-indexer/types"
-	"github.com/figment-networks/oasishub-indexer/utils/logger"
 	"github.com/jinzhu/gorm"
 
 	"github.com/figment-networks/oasishub-indexer/model"
@@ -22,8 +21,8 @@ type BlockSeqStore interface {
 	FindByHeight(int64) (*model.BlockSeq, error)
 	GetAvgRecentTimes(int64) (*GetAvgRecentTimesResult, error)
 	FindMostRecent() (*model.BlockSeq, error)
-	DeleteOlderThan(time.Time, []ActivityPeriodRow) (*int64, error)
-	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]BlockSeqSummary, error)
+	FindChangesSince(int64) (*ChangeSetRow, error)
+	Summarize(types.SummaryInterval, time.Time) ([]BlockSeqSummary, error)
 }
 
 func NewBlockSeqStore(db *gorm.DB) *blockSeqStore {
@@ -85,71 +84,34 @@ func (s *blockSeqStore) FindMostRecent() (*model.BlockSeq, error) {
 	return blockSeq, nil
 }
 
-// DeleteOlderThan deletes block sequence older than given threshold
-func (s *blockSeqStore) DeleteOlderThan(purgeThreshold time.Time, activityPeriods []ActivityPeriodRow) (*int64, error) {
-	tx := s.db.
-		Unscoped()
-
-	hasIntervals := false
-	for _, activityPeriod := range activityPeriods {
-		// Make sure that there are many intervals (ie. days) in period
-		if !activityPeriod.Min.Equal(activityPeriod.Max) {
-			hasIntervals = true
-			// Thus, we do not add 1 day to Max because we don't want to purge sequences within last day of period
-			tx = tx.Where("time >= ? AND time < ?", activityPeriod.Min, activityPeriod.Max)
-		}
-	}
-
-	if hasIntervals {
-		tx.Where("time < ?", purgeThreshold).
-			Delete(&model.BlockSeq{})
-
-		if tx.Error != nil {
-			return nil, checkErr(tx.Error)
-		}
-	} else {
-		logger.Info("no block sequences to purge")
-	}
-
-	return &tx.RowsAffected, nil
-}
-
 type BlockSeqSummary struct {
 	TimeBucket   types.Time `json:"time_bucket"`
 	Count        int64      `json:"count"`
 	BlockTimeAvg float64    `json:"block_time_avg"`
 }
 
-// Summarize gets the summarized version of block sequences
-func (s *blockSeqStore) Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]BlockSeqSummary, error) {
-	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSummaryStore_CalculateSummary"))
+// FindChangesSince finds block sequences created after given height
+func (s *blockSeqStore) FindChangesSince(height int64) (*ChangeSetRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSeqStore_FindChangesSince"))
 	defer t.ObserveDuration()
 
-	tx := s.db.
+	return findChangesSince(s.db, getChangesSinceQuery(model.BlockSeq{}.TableName()), height)
+}
+
+// Summarize gets the summarized version of block sequences for buckets starting from given time
+func (s *blockSeqStore) Summarize(interval types.SummaryInterval, since time.Time) ([]BlockSeqSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSeqStore_Summarize"))
+	defer t.ObserveDuration()
+
+	var models []BlockSeqSummary
+	err := s.db.
 		Table(model.BlockSeq{}.TableName()).
 		Select(summarizeBlocksQuerySelect, interval).
+		Where("time >= DATE_TRUNC(?, ?::TIMESTAMPTZ)", interval, since).
 		Order("time_bucket").
-		Group("time_bucket")
-
-	if len(activityPeriods) == 1 {
-		activityPeriod := activityPeriods[0]
-		tx = tx.Or("time < ? OR time >= ?", activityPeriod.Min, activityPeriod.Max)
-	} else {
-		for i, activityPeriod := range activityPeriods {
-			isLast := i == len(activityPeriods)-1
-
-			if isLast {
-				tx = tx.Or("time >= ?", activityPeriod.Max)
-			} else {
-				duration, err := interval.ToDuration()
-				if err != nil {
-					return nil, err
-				}
-				tx = tx.Or("time >= ? AND time < ?", activityPeriod.Max.Add(duration), activityPeriods[i+1].Min)
-			}
-		}
-	}
+		Group("time_bucket").
+		Find(&models).
+		Error
 
-	var models []BlockSeqSummary
-	return models, tx.Find(&models).Error
+	return models, err
 }
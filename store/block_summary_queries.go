@@ -12,5 +12,16 @@ WHERE time_bucket >= (
 	LIMIT 1
 ) - ?::INTERVAL AND time_interval = ?
 ORDER BY time_bucket
+`
+
+	rollupBlockSummaryQuery = `
+SELECT
+  DATE_TRUNC(?, time_bucket)               AS time_bucket,
+  SUM(count)                               AS count,
+  SUM(block_time_avg * count) / SUM(count) AS block_time_avg
+FROM block_summary
+WHERE time_interval = ? AND index_version = ? AND time_bucket >= DATE_TRUNC(?, ?::TIMESTAMPTZ)
+GROUP BY 1
+ORDER BY 1
 `
 )
@@ -1,7 +1,6 @@
 package store
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/metrics"
@@ -19,9 +18,8 @@ type BlockSummaryStore interface {
 
 	Find(*model.BlockSummary) (*model.BlockSummary, error)
 	FindMostRecentByInterval(types.SummaryInterval) (*model.BlockSummary, error)
-	FindActivityPeriods(types.SummaryInterval, int64) ([]ActivityPeriodRow, error)
 	FindSummary(types.SummaryInterval, string) ([]model.BlockSummary, error)
-	DeleteOlderThan(types.SummaryInterval, time.Time) (*int64, error)
+	Rollup(types.SummaryInterval, types.SummaryInterval, time.Time, int64) ([]BlockSeqSummary, error)
 }
 
 func NewBlockSummaryStore(db *gorm.DB) *blockSummaryStore {
@@ -61,23 +59,6 @@ func (s *blockSummaryStore) FindMostRecentByInterval(interval types.SummaryInter
 	return &result, checkErr(err)
 }
 
-type ActivityPeriodRow struct {
-	Period int64
-	Min    types.Time
-	Max    types.Time
-}
-
-// FindActivityPeriods Finds activity periods
-func (s *blockSummaryStore) FindActivityPeriods(interval types.SummaryInterval, indexVersion int64) ([]ActivityPeriodRow, error) {
-	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSummaryStore_FindActivityPeriods"))
-	defer t.ObserveDuration()
-
-	query := getActivityPeriodsQuery(model.BlockSummary{}.TableName())
-
-	var res []ActivityPeriodRow
-	return res, s.db.Raw(query, fmt.Sprintf("1%s", interval), interval, indexVersion).Find(&res).Error
-}
-
 // FindSummary Gets summary of block sequences
 func (s *blockSummaryStore) FindSummary(interval types.SummaryInterval, period string) ([]model.BlockSummary, error) {
 	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSummaryStore_FindSummary"))
@@ -87,16 +68,11 @@ func (s *blockSummaryStore) FindSummary(interval types.SummaryInterval, period s
 	return res, s.db.Raw(allBlocksSummaryForIntervalQuery, interval, period, interval).Find(&res).Error
 }
 
-// DeleteOlderThan deletes block summary records older than given threshold
-func (s *blockSummaryStore) DeleteOlderThan(interval types.SummaryInterval, purgeThreshold time.Time) (*int64, error) {
-	res := s.db.
-		Unscoped().
-		Where("time_interval = ? AND time_bucket < ?", interval, purgeThreshold).
-		Delete(&model.BlockSummary{})
-
-	if res.Error != nil {
-		return nil, checkErr(res.Error)
-	}
+// Rollup aggregates block summaries of one interval into buckets of a larger interval starting from given time
+func (s *blockSummaryStore) Rollup(from, to types.SummaryInterval, since time.Time, indexVersion int64) ([]BlockSeqSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("BlockSummaryStore_Rollup"))
+	defer t.ObserveDuration()
 
-	return &res.RowsAffected, nil
+	var res []BlockSeqSummary
+	return res, s.db.Raw(rollupBlockSummaryQuery, to, from, indexVersion, to, since).Find(&res).Error
 }
@@ -3,35 +3,29 @@ package store
 import "fmt"
 
 const (
-	activityPeriodsQuery = `
-WITH cte AS (
-    SELECT
-      time_bucket,
-      sum(CASE WHEN diff IS NULL OR diff > ? :: INTERVAL
-        THEN 1
-          ELSE NULL END)
-      OVER (
-        ORDER BY time_bucket ) AS period
-    FROM (
-           SELECT
-             time_bucket,
-             time_bucket - lag(time_bucket, 1)
-             OVER (
-               ORDER BY time_bucket ) AS diff
-           FROM %v
-           WHERE time_interval = ? AND index_version = ?
-         ) AS x
-)
+	lastByKeysQuery = `
+SELECT *
+FROM (
+  SELECT
+    *,
+    ROW_NUMBER() OVER (PARTITION BY %[2]v ORDER BY height DESC, id DESC) AS row_number
+  FROM %[1]v
+  WHERE %[2]v IN (?) %[3]v
+) AS x
+WHERE x.row_number <= ?
+ORDER BY %[2]v, height DESC, id DESC
+`
+
+	changesSinceQuery = `
 SELECT
-  period,
-  MIN(time_bucket),
-  MAX(time_bucket)
-FROM cte
-GROUP BY period
-ORDER BY period
+  MIN(time)   AS min_time,
+  MAX(height) AS max_height
+FROM %v
+WHERE height > ?
+HAVING COUNT(*) > 0
 `
 )
 
-func getActivityPeriodsQuery(tableName string) string {
-	return fmt.Sprintf(activityPeriodsQuery, tableName)
+func getChangesSinceQuery(tableName string) string {
+	return fmt.Sprintf(changesSinceQuery, tableName)
 }
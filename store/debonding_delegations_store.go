@@ -0,0 +1,63 @@
+package store
+
+import (
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ DebondingDelegationsStore = (*debondingDelegationsStore)(nil)
+)
+
+type DebondingDelegationsStore interface {
+	BaseStore
+
+	FindActiveAt(int64) ([]model.DebondingDelegation, error)
+	Revert(int64) error
+}
+
+func NewDebondingDelegationsStore(db *gorm.DB) *debondingDelegationsStore {
+	return &debondingDelegationsStore{scoped(db, model.DebondingDelegation{})}
+}
+
+// debondingDelegationsStore handles operations on debonding delegations
+type debondingDelegationsStore struct {
+	baseStore
+}
+
+// FindActiveAt returns debonding delegations which are in staking state at given height
+func (s debondingDelegationsStore) FindActiveAt(height int64) ([]model.DebondingDelegation, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("DebondingDelegationsStore_FindActiveAt"))
+	defer t.ObserveDuration()
+
+	var result []model.DebondingDelegation
+
+	err := s.db.
+		Where("start_height <= ? AND (end_height IS NULL OR end_height > ?)", height, height).
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// Revert undoes changes of debonding delegations made at given height, so the height can be indexed again
+func (s debondingDelegationsStore) Revert(height int64) error {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("DebondingDelegationsStore_Revert"))
+	defer t.ObserveDuration()
+
+	err := s.db.
+		Where("start_height = ?", height).
+		Delete(&model.DebondingDelegation{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return s.db.
+		Model(&model.DebondingDelegation{}).
+		Where("end_height = ?", height).
+		Update("end_height", gorm.Expr("NULL")).
+		Error
+}
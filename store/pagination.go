@@ -0,0 +1,109 @@
+package store
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	PageDirectionDesc PageDirection = "desc"
+	PageDirectionAsc  PageDirection = "asc"
+)
+
+var (
+	ErrInvalidCursor        = errors.New("invalid cursor")
+	ErrInvalidPageDirection = errors.New("invalid page direction")
+)
+
+// PageDirection is sort order of paginated records
+type PageDirection string
+
+// Valid returns true if direction is supported
+func (d PageDirection) Valid() bool {
+	return d == PageDirectionDesc || d == PageDirectionAsc
+}
+
+// Cursor points to the last record of the page
+type Cursor struct {
+	Height int64
+	ID     types.ID
+}
+
+// Encode returns opaque string representation of cursor
+func (c Cursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.Height, c.ID)))
+}
+
+// DecodeCursor parses cursor returned by Encode
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{Height: height, ID: types.ID(id)}, nil
+}
+
+// Pagination selects single page of records ordered by height and id.
+// Zero Limit returns all records after the cursor
+type Pagination struct {
+	Limit     int64
+	Cursor    *Cursor
+	Direction PageDirection
+}
+
+// NextCursor returns cursor of the next page for page ending with given record.
+// It returns nil when page is not full, so there are no more records
+func (p Pagination) NextCursor(count int, height int64, id types.ID) *Cursor {
+	if p.Limit == 0 || int64(count) < p.Limit {
+		return nil
+	}
+	return &Cursor{Height: height, ID: id}
+}
+
+func (p Pagination) direction() PageDirection {
+	if !p.Direction.Valid() {
+		return PageDirectionDesc
+	}
+	return p.Direction
+}
+
+// paginate applies cursor, order and limit to the query
+func (p Pagination) paginate(tx *gorm.DB, heightColumn string) *gorm.DB {
+	direction := p.direction()
+
+	if p.Cursor != nil {
+		op := "<"
+		if direction == PageDirectionAsc {
+			op = ">"
+		}
+		tx = tx.Where(fmt.Sprintf("(%s, id) %s (?, ?)", heightColumn, op), p.Cursor.Height, p.Cursor.ID)
+	}
+
+	tx = tx.Order(fmt.Sprintf("%s %s, id %s", heightColumn, direction, direction))
+
+	if p.Limit > 0 {
+		tx = tx.Limit(p.Limit)
+	}
+	return tx
+}
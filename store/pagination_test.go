@@ -0,0 +1,167 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var errQueryRecorded = errors.New("query recorded")
+
+// recordingConn records queries instead of executing them
+type recordingConn struct {
+	query string
+	args  []interface{}
+}
+
+func (c *recordingConn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	c.query, c.args = query, args
+	return nil, errQueryRecorded
+}
+
+func (c *recordingConn) Prepare(query string) (*sql.Stmt, error) {
+	c.query = query
+	return nil, errQueryRecorded
+}
+
+func (c *recordingConn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	c.query, c.args = query, args
+	return nil, errQueryRecorded
+}
+
+func (c *recordingConn) QueryRow(query string, args ...interface{}) *sql.Row {
+	c.query, c.args = query, args
+	return nil
+}
+
+func TestCursor_Encode(t *testing.T) {
+	cursor := Cursor{Height: 1234, ID: types.ID(56)}
+
+	got, err := DecodeCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *got != cursor {
+		t.Errorf("unexpected cursor, want %+v; got %+v", cursor, *got)
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		description string
+		cursor      string
+	}{
+		{"rejects invalid base64", "not a cursor!"},
+		{"rejects missing id", encode("1234")},
+		{"rejects too many parts", encode("1234:56:78")},
+		{"rejects invalid height", encode("abc:56")},
+		{"rejects invalid id", encode("1234:abc")},
+		{"rejects padded base64", base64.URLEncoding.EncodeToString([]byte("1234:56"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.cursor); err != ErrInvalidCursor {
+				t.Errorf("unexpected error, want %v; got %v", ErrInvalidCursor, err)
+			}
+		})
+	}
+}
+
+func TestPagination_NextCursor(t *testing.T) {
+	tests := []struct {
+		description string
+		limit       int64
+		count       int
+		want        *Cursor
+	}{
+		{"returns cursor of last record when page is full", 10, 10, &Cursor{Height: 100, ID: types.ID(5)}},
+		{"returns nil on last page", 10, 7, nil},
+		{"returns nil on empty page", 10, 0, nil},
+		{"returns nil when there is no limit", 0, 10, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := Pagination{Limit: tt.limit}.NextCursor(tt.count, 100, types.ID(5))
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("unexpected cursor, want %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPagination_paginate(t *testing.T) {
+	tests := []struct {
+		description string
+		page        Pagination
+		want        []string
+		wantArgs    int
+	}{
+		{
+			description: "orders first page descending by default",
+			page:        Pagination{Limit: 10},
+			want:        []string{"ORDER BY height desc, id desc", "LIMIT 10"},
+		},
+		{
+			description: "selects records before cursor when descending",
+			page:        Pagination{Limit: 10, Cursor: &Cursor{Height: 100, ID: types.ID(5)}},
+			want:        []string{"(height, id) < ($1, $2)", "ORDER BY height desc, id desc", "LIMIT 10"},
+			wantArgs:    2,
+		},
+		{
+			description: "selects records after cursor when ascending",
+			page:        Pagination{Limit: 10, Cursor: &Cursor{Height: 100, ID: types.ID(5)}, Direction: PageDirectionAsc},
+			want:        []string{"(height, id) > ($1, $2)", "ORDER BY height asc, id asc", "LIMIT 10"},
+			wantArgs:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			conn := &recordingConn{}
+			db, err := gorm.Open("postgres", conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			db.LogMode(false)
+
+			var result []struct{}
+			tt.page.paginate(db.Table("test"), "height").Find(&result)
+
+			for _, want := range tt.want {
+				if !strings.Contains(conn.query, want) {
+					t.Errorf("query %q does not contain %q", conn.query, want)
+				}
+			}
+			if len(conn.args) != tt.wantArgs {
+				t.Errorf("unexpected number of args, want %d; got %d", tt.wantArgs, len(conn.args))
+			}
+		})
+	}
+
+	t.Run("does not limit page without limit", func(t *testing.T) {
+		conn := &recordingConn{}
+		db, err := gorm.Open("postgres", conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		db.LogMode(false)
+
+		var result []struct{}
+		Pagination{}.paginate(db.Table("test"), "height").Find(&result)
+
+		if strings.Contains(conn.query, "LIMIT") {
+			t.Errorf("query %q should not be limited", conn.query)
+		}
+	})
+}
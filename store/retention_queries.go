@@ -0,0 +1,11 @@
+package store
+
+const (
+	balanceEventTimeExpr = `(SELECT s.time FROM syncables AS s WHERE s.height = balance_events.height)`
+
+	importRecordsQuery = `
+INSERT INTO %s
+SELECT * FROM JSON_POPULATE_RECORDSET(NULL::%s, ?::JSON)
+ON CONFLICT DO NOTHING
+`
+)
@@ -0,0 +1,311 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	// deleteBatchSize is the maximum number of records deleted by IDs in one statement
+	deleteBatchSize = 10000
+)
+
+var (
+	_ RetentionStore = (*retentionStore)(nil)
+
+	ErrUnknownRetentionTable = errors.New("unknown retention table")
+	ErrCoverageNotSupported  = errors.New("summary coverage is not supported for table")
+)
+
+type RetentionStore interface {
+	FindMostRecentTime(string, types.SummaryInterval) (*types.Time, error)
+	FindUncovered(RetentionQuery) ([]UncoveredBucketRow, error)
+	DeleteCovered(RetentionQuery) (*int64, error)
+	DeleteByIDs(string, []int64) (*int64, error)
+	ExportCovered(RetentionQuery, func(ExportedRow) error) error
+	Import(string, []json.RawMessage) (*int64, error)
+}
+
+func NewRetentionStore(db *gorm.DB) *retentionStore {
+	return &retentionStore{
+		db: db,
+	}
+}
+
+// retentionStore handles purging of records according to retention policies
+type retentionStore struct {
+	db *gorm.DB
+}
+
+// retentionTarget describes how records of purgeable table are matched with their summaries
+type retentionTarget struct {
+	model interface{}
+	// timeExpr is SQL expression returning time of the record
+	timeExpr string
+	// summaryModel holds summaries of the records
+	summaryModel interface{ TableName() string }
+	// isSummary is true when records are summaries themselves and have time interval
+	isSummary bool
+}
+
+var retentionTargets = map[string]retentionTarget{
+	model.BlockSeq{}.TableName(): {
+		model:        &model.BlockSeq{},
+		timeExpr:     "time",
+		summaryModel: model.BlockSummary{},
+	},
+	model.ValidatorSeq{}.TableName(): {
+		model:        &model.ValidatorSeq{},
+		timeExpr:     "time",
+		summaryModel: model.ValidatorSummary{},
+	},
+	model.BalanceEvent{}.TableName(): {
+		model:        &model.BalanceEvent{},
+		timeExpr:     balanceEventTimeExpr,
+		summaryModel: model.BalanceSummary{},
+	},
+	model.SystemEvent{}.TableName(): {
+		model:    &model.SystemEvent{},
+		timeExpr: "time",
+	},
+	model.BlockSummary{}.TableName(): {
+		model:        &model.BlockSummary{},
+		timeExpr:     "time_bucket",
+		summaryModel: model.BlockSummary{},
+		isSummary:    true,
+	},
+	model.ValidatorSummary{}.TableName(): {
+		model:        &model.ValidatorSummary{},
+		timeExpr:     "time_bucket",
+		summaryModel: model.ValidatorSummary{},
+		isSummary:    true,
+	},
+	model.BalanceSummary{}.TableName(): {
+		model:        &model.BalanceSummary{},
+		timeExpr:     "time_bucket",
+		summaryModel: model.BalanceSummary{},
+		isSummary:    true,
+	},
+}
+
+// RetentionQuery selects records of the table older than threshold
+type RetentionQuery struct {
+	Table        string
+	TimeInterval types.SummaryInterval
+	Threshold    time.Time
+	// Coverage is summary interval which has to exist for record's time bucket. Empty means no check
+	Coverage     types.SummaryInterval
+	IndexVersion int64
+}
+
+// UncoveredBucketRow contains number of records in time bucket without summary
+type UncoveredBucketRow struct {
+	TimeBucket types.Time
+	Count      int64
+}
+
+// ExportedRow contains record as JSON together with its ID and UTC day it belongs to
+type ExportedRow struct {
+	ID   int64
+	Day  time.Time
+	Data json.RawMessage
+}
+
+// FindMostRecentTime returns time of the most recent record in table
+func (s *retentionStore) FindMostRecentTime(table string, interval types.SummaryInterval) (*types.Time, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_FindMostRecentTime"))
+	defer t.ObserveDuration()
+
+	target, ok := retentionTargets[table]
+	if !ok {
+		return nil, ErrUnknownRetentionTable
+	}
+
+	tx := s.db.
+		Table(table).
+		Select(fmt.Sprintf("MAX(%s) AS time", target.timeExpr)).
+		Having("COUNT(*) > 0")
+
+	if target.isSummary && interval != "" {
+		tx = tx.Where("time_interval = ?", interval)
+	}
+
+	var result struct {
+		Time types.Time
+	}
+	err := tx.Scan(&result).Error
+
+	return &result.Time, checkErr(err)
+}
+
+// FindUncovered returns time buckets of records older than threshold which have no summary
+func (s *retentionStore) FindUncovered(query RetentionQuery) ([]UncoveredBucketRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_FindUncovered"))
+	defer t.ObserveDuration()
+
+	target, ok := retentionTargets[query.Table]
+	if !ok {
+		return nil, ErrUnknownRetentionTable
+	}
+	if target.summaryModel == nil {
+		return nil, ErrCoverageNotSupported
+	}
+
+	tx := s.olderThan(s.db.Table(query.Table), target, query).
+		Select(fmt.Sprintf("DATE_TRUNC(?, %s) AS time_bucket, COUNT(*) AS count", target.timeExpr), query.Coverage).
+		Where(fmt.Sprintf("DATE_TRUNC(?, %s) NOT IN (?)", target.timeExpr), query.Coverage, s.coveredBuckets(target, query)).
+		Group("1").
+		Order("1")
+
+	var res []UncoveredBucketRow
+	return res, tx.Scan(&res).Error
+}
+
+// DeleteCovered deletes records older than threshold. When coverage is given only records with summary are deleted
+func (s *retentionStore) DeleteCovered(query RetentionQuery) (*int64, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_DeleteCovered"))
+	defer t.ObserveDuration()
+
+	target, ok := retentionTargets[query.Table]
+	if !ok {
+		return nil, ErrUnknownRetentionTable
+	}
+
+	tx, err := s.covered(s.db.Unscoped(), target, query)
+	if err != nil {
+		return nil, err
+	}
+
+	tx = tx.Delete(target.model)
+	if tx.Error != nil {
+		return nil, checkErr(tx.Error)
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// DeleteByIDs deletes records of the table with given IDs
+func (s *retentionStore) DeleteByIDs(table string, ids []int64) (*int64, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_DeleteByIDs"))
+	defer t.ObserveDuration()
+
+	target, ok := retentionTargets[table]
+	if !ok {
+		return nil, ErrUnknownRetentionTable
+	}
+
+	var deleted int64
+	for start := 0; start < len(ids); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		tx := s.db.
+			Unscoped().
+			Where("id IN (?)", ids[start:end]).
+			Delete(target.model)
+		if tx.Error != nil {
+			return nil, checkErr(tx.Error)
+		}
+		deleted += tx.RowsAffected
+	}
+
+	return &deleted, nil
+}
+
+// ExportCovered streams records which would be deleted by DeleteCovered as JSON
+func (s *retentionStore) ExportCovered(query RetentionQuery, fn func(ExportedRow) error) error {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_ExportCovered"))
+	defer t.ObserveDuration()
+
+	target, ok := retentionTargets[query.Table]
+	if !ok {
+		return ErrUnknownRetentionTable
+	}
+
+	tx, err := s.covered(s.db.Table(query.Table), target, query)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.
+		Select(fmt.Sprintf("id, DATE_TRUNC('day', (%s) AT TIME ZONE 'UTC') AS day, ROW_TO_JSON(%s.*) AS data", target.timeExpr, query.Table)).
+		Order("id").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var day time.Time
+		var data []byte
+		if err := rows.Scan(&id, &day, &data); err != nil {
+			return err
+		}
+		if err := fn(ExportedRow{ID: id, Day: day, Data: data}); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Import inserts exported records back into the table. Already existing records are ignored
+func (s *retentionStore) Import(table string, data []json.RawMessage) (*int64, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("RetentionStore_Import"))
+	defer t.ObserveDuration()
+
+	if _, ok := retentionTargets[table]; !ok {
+		return nil, ErrUnknownRetentionTable
+	}
+
+	records, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := s.db.Exec(fmt.Sprintf(importRecordsQuery, table, table), string(records))
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &tx.RowsAffected, nil
+}
+
+// covered narrows tx to records older than threshold. When coverage is given only records with summary are matched
+func (s *retentionStore) covered(tx *gorm.DB, target retentionTarget, query RetentionQuery) (*gorm.DB, error) {
+	tx = s.olderThan(tx, target, query)
+
+	if query.Coverage != "" {
+		if target.summaryModel == nil {
+			return nil, ErrCoverageNotSupported
+		}
+		tx = tx.Where(fmt.Sprintf("DATE_TRUNC(?, %s) IN (?)", target.timeExpr), query.Coverage, s.coveredBuckets(target, query))
+	}
+	return tx, nil
+}
+
+func (s *retentionStore) olderThan(tx *gorm.DB, target retentionTarget, query RetentionQuery) *gorm.DB {
+	tx = tx.Where(fmt.Sprintf("%s < ?", target.timeExpr), query.Threshold)
+	if target.isSummary {
+		tx = tx.Where("time_interval = ?", query.TimeInterval)
+	}
+	return tx
+}
+
+func (s *retentionStore) coveredBuckets(target retentionTarget, query RetentionQuery) interface{} {
+	return s.db.
+		Table(target.summaryModel.TableName()).
+		Select("time_bucket").
+		Where("time_interval = ? AND index_version = ?", query.Coverage, query.IndexVersion).
+		QueryExpr()
+}
@@ -29,11 +29,14 @@ func New(connStr string) (*Store, error) {
 		db: conn,
 
 		Database:      NewDatabaseStore(conn),
+		Retention:     NewRetentionStore(conn),
 		Syncables:     NewSyncablesStore(conn),
 		Reports:       NewReportsStore(conn),
 		SystemEvents:  NewSystemEventsStore(conn),
 		BalanceEvents: NewBalanceEventsStore(conn),
 
+		DebondingDelegations: NewDebondingDelegationsStore(conn),
+
 		BlockSeq:               NewBlockSeqStore(conn),
 		DebondingDelegationSeq: NewDebondingDelegationSeqStore(conn),
 		DelegationSeq:          NewDelegationSeqStore(conn),
@@ -45,8 +48,20 @@ func New(connStr string) (*Store, error) {
 		ValidatorSummary: NewValidatorSummaryStore(conn),
 		BalanceSummary:   NewBalanceSummaryStore(conn),
 
+		SummaryWatermarks: NewSummaryWatermarksStore(conn),
+
+		WebhookSubscriptions: NewWebhookSubscriptionsStore(conn),
+		WebhookDeliveries:    NewWebhookDeliveriesStore(conn),
+
 		AccountAgg:   NewAccountAggStore(conn),
 		ValidatorAgg: NewValidatorAggStore(conn),
+
+		ValidatorUptimes: NewValidatorUptimesStore(conn),
+
+		SystemEventAcks: NewSystemEventAcksStore(conn),
+		ValidatorMutes:  NewValidatorMutesStore(conn),
+
+		APIKeys: NewAPIKeysStore(conn),
 	}, nil
 }
 
@@ -55,11 +70,14 @@ type Store struct {
 	db *gorm.DB
 
 	Database      DatabaseStore
+	Retention     RetentionStore
 	Syncables     SyncablesStore
 	Reports       ReportsStore
 	SystemEvents  SystemEventsStore
 	BalanceEvents BalanceEventsStore
 
+	DebondingDelegations DebondingDelegationsStore
+
 	BlockSeq               BlockSeqStore
 	DebondingDelegationSeq DebondingDelegationSeqStore
 	DelegationSeq          DelegationSeqStore
@@ -71,8 +89,20 @@ type Store struct {
 	ValidatorSummary ValidatorSummaryStore
 	BalanceSummary   BalanceSummaryStore
 
+	SummaryWatermarks SummaryWatermarksStore
+
+	WebhookSubscriptions WebhookSubscriptionsStore
+	WebhookDeliveries    WebhookDeliveriesStore
+
 	AccountAgg   AccountAggStore
 	ValidatorAgg ValidatorAggStore
+
+	ValidatorUptimes ValidatorUptimesStore
+
+	SystemEventAcks SystemEventAcksStore
+	ValidatorMutes  ValidatorMutesStore
+
+	APIKeys APIKeysStore
 }
 
 // Test checks the connection status
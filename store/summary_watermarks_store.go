@@ -0,0 +1,67 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ SummaryWatermarksStore = (*summaryWatermarksStore)(nil)
+)
+
+type SummaryWatermarksStore interface {
+	BaseStore
+
+	FindBySource(string, int64) (*model.SummaryWatermark, error)
+	CreateOrUpdate(*model.SummaryWatermark) error
+	Rewind(int64, int64) error
+}
+
+func NewSummaryWatermarksStore(db *gorm.DB) *summaryWatermarksStore {
+	return &summaryWatermarksStore{scoped(db, model.SummaryWatermark{})}
+}
+
+// summaryWatermarksStore handles operations on summary watermarks
+type summaryWatermarksStore struct {
+	baseStore
+}
+
+// FindBySource returns the watermark for source table and index version
+func (s summaryWatermarksStore) FindBySource(source string, indexVersion int64) (*model.SummaryWatermark, error) {
+	query := &model.SummaryWatermark{
+		Source:       source,
+		IndexVersion: indexVersion,
+	}
+	result := &model.SummaryWatermark{}
+
+	err := s.db.
+		Where(query).
+		First(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// CreateOrUpdate creates a new watermark or updates an existing one
+func (s summaryWatermarksStore) CreateOrUpdate(val *model.SummaryWatermark) error {
+	existing, err := s.FindBySource(val.Source, val.IndexVersion)
+	if err != nil {
+		if err == ErrNotFound {
+			return s.Create(val)
+		}
+		return err
+	}
+
+	existing.Update(*val)
+	return s.Save(existing)
+}
+
+// Rewind moves watermarks of index version which are above given height back to that height,
+// so records reindexed after it are summarized again
+func (s summaryWatermarksStore) Rewind(indexVersion int64, height int64) error {
+	return s.db.
+		Model(&model.SummaryWatermark{}).
+		Where("index_version = ? AND height > ?", indexVersion, height).
+		Update("height", height).
+		Error
+}
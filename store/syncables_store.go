@@ -1,6 +1,8 @@
 package store
 
 import (
+	"time"
+
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/jinzhu/gorm"
 )
@@ -14,11 +16,13 @@ type SyncablesStore interface {
 
 	FindByHeight(int64) (*model.Syncable, error)
 	FindMostRecent() (*model.Syncable, error)
+	FindMostRecentAt(time.Time) (*model.Syncable, error)
 	FindSmallestIndexVersion() (*int64, error)
 	FindFirstByDifferentIndexVersion(int64) (*model.Syncable, error)
 	FindMostRecentByDifferentIndexVersion(int64) (*model.Syncable, error)
 	CreateOrUpdate(*model.Syncable) error
 	ResetProcessedAtForRange(int64, int64) error
+	FindProcessedHeights(int64, int64, int64) ([]int64, error)
 }
 
 func NewSyncablesStore(db *gorm.DB) *syncablesStore {
@@ -53,6 +57,18 @@ func (s syncablesStore) FindMostRecent() (*model.Syncable, error) {
 	return result, checkErr(err)
 }
 
+// FindMostRecentAt returns the most recent syncable at or before given time
+func (s syncablesStore) FindMostRecentAt(t time.Time) (*model.Syncable, error) {
+	result := &model.Syncable{}
+
+	err := s.db.
+		Where("time <= ?", t).
+		Order("time desc, height desc").
+		First(result).Error
+
+	return result, checkErr(err)
+}
+
 // FindSmallestIndexVersion returns smallest index version
 func (s syncablesStore) FindSmallestIndexVersion() (*int64, error) {
 	result := &model.Syncable{}
@@ -112,3 +128,18 @@ func (s syncablesStore) ResetProcessedAtForRange( startHeight int64, endHeight i
 
 	return checkErr(err)
 }
+
+// FindProcessedHeights returns at most limit processed heights in given range in ascending order
+func (s syncablesStore) FindProcessedHeights(startHeight int64, endHeight int64, limit int64) ([]int64, error) {
+	var result []int64
+
+	err := s.db.
+		Model(&model.Syncable{}).
+		Where("processed_at IS NOT NULL AND height >= ? AND height <= ?", startHeight, endHeight).
+		Order("height").
+		Limit(limit).
+		Pluck("height", &result).
+		Error
+
+	return result, checkErr(err)
+}
@@ -0,0 +1,35 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ SystemEventAcksStore = (*systemEventAcksStore)(nil)
+)
+
+type SystemEventAcksStore interface {
+	BaseStore
+
+	CreateOrUpdate(*model.SystemEventAck) error
+}
+
+func NewSystemEventAcksStore(db *gorm.DB) *systemEventAcksStore {
+	return &systemEventAcksStore{scoped(db, model.SystemEventAck{})}
+}
+
+// systemEventAcksStore handles operations on system event acknowledgements
+type systemEventAcksStore struct {
+	baseStore
+}
+
+// CreateOrUpdate creates acknowledgement or updates note when subscriber already acknowledged system event
+func (s systemEventAcksStore) CreateOrUpdate(val *model.SystemEventAck) error {
+	err := s.db.
+		Set("gorm:insert_option", "ON CONFLICT (system_event_id, subscriber) DO UPDATE SET note = EXCLUDED.note, updated_at = EXCLUDED.updated_at").
+		Create(val).
+		Error
+
+	return checkErr(err)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/jinzhu/gorm"
 	"time"
 )
@@ -13,12 +14,14 @@ var (
 type SystemEventsStore interface {
 	BaseStore
 
+	FindByID(types.ID) (*model.SystemEvent, error)
 	FindByHeight(int64) ([]model.SystemEvent, error)
-	FindByActor(string, FindSystemEventByActorQuery) ([]model.SystemEvent, error)
+	FindByActor(string, FindSystemEventByActorQuery, Pagination) ([]model.SystemEvent, error)
+	FindLastByActors([]string, *model.SystemEventKind, int64) ([]model.SystemEvent, error)
 	FindUnique(int64, string, model.SystemEventKind) (*model.SystemEvent, error)
 	CreateOrUpdate(*model.SystemEvent) error
 	FindMostRecent() (*model.SystemEvent, error)
-	DeleteOlderThan(time.Time) (*int64, error)
+	FindNew(FindNewSystemEventsQuery) ([]model.SystemEvent, error)
 }
 
 func NewSystemEventsStore(db *gorm.DB) *systemEventsStore {
@@ -30,13 +33,20 @@ type systemEventsStore struct {
 	baseStore
 }
 
+// FindByID returns system event by id
+func (s systemEventsStore) FindByID(id types.ID) (*model.SystemEvent, error) {
+	result := &model.SystemEvent{}
+	err := findBy(s.db, result, "id", id)
+	return result, checkErr(err)
+}
+
 // FindByHeight returns system events by height
 func (s systemEventsStore) FindByHeight(height int64) ([]model.SystemEvent, error) {
 	var result []model.SystemEvent
 
 	err := s.db.
 		Where("height = ?", height).
-		Find(result).
+		Find(&result).
 		Error
 
 	return result, checkErr(err)
@@ -45,10 +55,15 @@ func (s systemEventsStore) FindByHeight(height int64) ([]model.SystemEvent, erro
 type FindSystemEventByActorQuery struct {
 	Kind      *model.SystemEventKind
 	MinHeight *int64
+
+	// Subscriber is required to exclude acknowledged or muted system events
+	Subscriber          string
+	ExcludeAcknowledged bool
+	ExcludeMuted        bool
 }
 
-// FindByActor returns system events by actor
-func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+// FindByActor returns page of system events by actor
+func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEventByActorQuery, page Pagination) ([]model.SystemEvent, error) {
 	var result []model.SystemEvent
 	q := model.SystemEvent{}
 	if query.Kind != nil {
@@ -63,13 +78,38 @@ func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEven
 		statement = statement.Where("height > ?", query.MinHeight)
 	}
 
-	err := statement.
+	if query.ExcludeAcknowledged {
+		statement = statement.Where("NOT EXISTS (SELECT 1 FROM system_event_acks a WHERE a.system_event_id = system_events.id AND a.subscriber = ?)", query.Subscriber)
+	}
+
+	if query.ExcludeMuted {
+		statement = statement.Where("NOT EXISTS (SELECT 1 FROM validator_mutes m WHERE m.address = system_events.actor AND m.subscriber = ? AND system_events.time >= m.start_time AND system_events.time < m.end_time)", query.Subscriber)
+	}
+
+	err := page.paginate(statement, "height").
 		Find(&result).
 		Error
 
 	return result, checkErr(err)
 }
 
+// FindLastByActors returns up to limit most recent system events for every actor
+func (s systemEventsStore) FindLastByActors(actors []string, kind *model.SystemEventKind, limit int64) ([]model.SystemEvent, error) {
+	var result []model.SystemEvent
+	if len(actors) == 0 {
+		return result, nil
+	}
+
+	var err error
+	if kind != nil {
+		err = findLastByKeys(s.db, &result, model.SystemEvent{}.TableName(), "actor", actors, limit, "AND kind = ?", *kind)
+	} else {
+		err = findLastByKeys(s.db, &result, model.SystemEvent{}.TableName(), "actor", actors, limit, "")
+	}
+
+	return result, checkErr(err)
+}
+
 // FindUnique returns unique system
 func (s systemEventsStore) FindUnique(height int64, address string, kind model.SystemEventKind) (*model.SystemEvent, error) {
 	q := model.SystemEvent{
@@ -111,12 +151,35 @@ func (s *systemEventsStore) FindMostRecent() (*model.SystemEvent, error) {
 	return systemEvent, nil
 }
 
-// DeleteOlderThan deletes system events older than given threshold
-func (s *systemEventsStore) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
+type FindNewSystemEventsQuery struct {
+	SubscriptionID types.ID
+	CreatedSince   time.Time
+	Actor          *string
+	Kind           *model.SystemEventKind
+	Limit          int64
+}
+
+// FindNew returns system events created since given time which are not queued for webhook subscription yet,
+// ordered by creation time
+func (s *systemEventsStore) FindNew(query FindNewSystemEventsQuery) ([]model.SystemEvent, error) {
+	var result []model.SystemEvent
+
 	tx := s.db.
-		Unscoped().
-		Where("time < ?", purgeThreshold).
-		Delete(&model.BlockSeq{})
+		Where("created_at >= ?", query.CreatedSince).
+		Where("NOT EXISTS (SELECT 1 FROM webhook_deliveries WHERE subscription_id = ? AND system_event_id = system_events.id)", query.SubscriptionID)
+
+	if query.Actor != nil {
+		tx = tx.Where("actor = ?", *query.Actor)
+	}
+	if query.Kind != nil {
+		tx = tx.Where("kind = ?", *query.Kind)
+	}
 
-	return &tx.RowsAffected, checkErr(tx.Error)
+	err := tx.
+		Order("created_at, id").
+		Limit(query.Limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
 }
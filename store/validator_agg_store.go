@@ -14,8 +14,10 @@ type ValidatorAggStore interface {
 
 	FindBy(string, interface{}) (*model.ValidatorAgg, error)
 	FindByAddress(string) (*model.ValidatorAgg, error)
+	FindByAddresses([]string) ([]model.ValidatorAgg, error)
 	FindByEntityUID(string) (*model.ValidatorAgg, error)
-	GetAllForHeightGreaterThan(int64) ([]model.ValidatorAgg, error)
+	GetAllForHeightGreaterThan(int64, Pagination) ([]model.ValidatorAgg, error)
+	FindAll() ([]model.ValidatorAgg, error)
 	CreateOrUpdate(val *model.ValidatorAgg) error
 }
 
@@ -41,17 +43,47 @@ func (s *validatorAggStore) FindByAddress(address string) (*model.ValidatorAgg,
 	return s.FindBy("address", address)
 }
 
+// FindByAddresses returns validators with given addresses
+func (s *validatorAggStore) FindByAddresses(addresses []string) ([]model.ValidatorAgg, error) {
+	var result []model.ValidatorAgg
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	err := s.db.
+		Where("address IN (?)", addresses).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // FindByEntityUID return validator by entity UID
 func (s *validatorAggStore) FindByEntityUID(key string) (*model.ValidatorAgg, error) {
 	return s.FindBy("entity_uid", key)
 }
 
-// GetAllForHeightGreaterThan returns validators who have been validating since given height
-func (s *validatorAggStore) GetAllForHeightGreaterThan(height int64) ([]model.ValidatorAgg, error) {
+// GetAllForHeightGreaterThan returns page of validators who have been validating since given height.
+// Validators are ordered by height they were first seen at
+func (s *validatorAggStore) GetAllForHeightGreaterThan(height int64, page Pagination) ([]model.ValidatorAgg, error) {
+	var result []model.ValidatorAgg
+
+	tx := s.baseStore.db.
+		Where("recent_as_validator_height >= ?", height)
+
+	err := page.paginate(tx, "started_at_height").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindAll returns all validators
+func (s *validatorAggStore) FindAll() ([]model.ValidatorAgg, error) {
 	var result []model.ValidatorAgg
 
-	err := s.baseStore.db.
-		Where("recent_as_validator_height >= ?", height).
+	err := s.db.
+		Order("id").
 		Find(&result).
 		Error
 
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ ValidatorMutesStore = (*validatorMutesStore)(nil)
+)
+
+type ValidatorMutesStore interface {
+	BaseStore
+}
+
+func NewValidatorMutesStore(db *gorm.DB) *validatorMutesStore {
+	return &validatorMutesStore{scoped(db, model.ValidatorMute{})}
+}
+
+// validatorMutesStore handles operations on validator mutes
+type validatorMutesStore struct {
+	baseStore
+}
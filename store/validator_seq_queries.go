@@ -16,6 +16,9 @@ const (
 	AVG(commission)               			 AS commission_avg,
    	MAX(commission)               			 AS commission_max,
    	MIN(commission)               			 AS commission_min,
+   	COALESCE(AVG(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_avg,
+   	COALESCE(MAX(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_max,
+   	COALESCE(MIN(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_min,
    	AVG(precommit_validated::INT)            AS uptime_avg,
    	SUM(precommit_validated::INT)            AS validated_sum,
    	COUNT(*) - SUM(precommit_validated::INT) AS not_validated_sum,
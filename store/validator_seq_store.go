@@ -18,10 +18,12 @@ type ValidatorSeqStore interface {
 
 	FindByHeightAndEntityUID(int64, string) (*model.ValidatorSeq, error)
 	FindByHeight(int64) ([]model.ValidatorSeq, error)
-	FindLastByAddress(string, int64) ([]model.ValidatorSeq, error)
+	FindLastByAddress(string, Pagination) ([]model.ValidatorSeq, error)
+	FindLastByAddresses([]string, int64) ([]model.ValidatorSeq, error)
+	FindFirst() (*model.ValidatorSeq, error)
 	FindMostRecent() (*model.ValidatorSeq, error)
-	DeleteOlderThan(time.Time) (*int64, error)
-	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]ValidatorSeqSummary, error)
+	FindChangesSince(int64) (*ChangeSetRow, error)
+	Summarize(types.SummaryInterval, time.Time) ([]ValidatorSeqSummary, error)
 }
 
 func NewValidatorSeqStore(db *gorm.DB) *validatorSeqStore {
@@ -68,23 +70,44 @@ func (s validatorSeqStore) FindByHeight(h int64) ([]model.ValidatorSeq, error) {
 	return result, checkErr(err)
 }
 
-// FindLastByAddress finds last validator sequences for given entity uid
-func (s validatorSeqStore) FindLastByAddress(address string, limit int64) ([]model.ValidatorSeq, error) {
+// FindLastByAddress finds page of validator sequences for given address
+func (s validatorSeqStore) FindLastByAddress(address string, page Pagination) ([]model.ValidatorSeq, error) {
 	q := model.ValidatorSeq{
 		Address: address,
 	}
 	var result []model.ValidatorSeq
 
-	err := s.db.
-		Where(&q).
-		Order("height DESC").
-		Limit(limit).
+	err := page.paginate(s.db.Where(&q), "height").
 		Find(&result).
 		Error
 
 	return result, checkErr(err)
 }
 
+// FindLastByAddresses finds up to limit most recent validator sequences for every address
+func (s validatorSeqStore) FindLastByAddresses(addresses []string, limit int64) ([]model.ValidatorSeq, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_FindLastByAddresses"))
+	defer t.ObserveDuration()
+
+	var result []model.ValidatorSeq
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	err := findLastByKeys(s.db, &result, model.ValidatorSeq{}.TableName(), "address", addresses, limit, "")
+	return result, checkErr(err)
+}
+
+// FindFirst finds validator sequence of the lowest height
+func (s *validatorSeqStore) FindFirst() (*model.ValidatorSeq, error) {
+	validatorSeq := &model.ValidatorSeq{}
+	err := s.db.
+		Order("height ASC").
+		Take(validatorSeq).
+		Error
+	return validatorSeq, checkErr(err)
+}
+
 // FindMostRecent finds most recent validator sequence
 func (s *validatorSeqStore) FindMostRecent() (*model.ValidatorSeq, error) {
 	validatorSeq := &model.ValidatorSeq{}
@@ -94,20 +117,6 @@ func (s *validatorSeqStore) FindMostRecent() (*model.ValidatorSeq, error) {
 	return validatorSeq, nil
 }
 
-// DeleteOlderThan deletes validator sequence older than given threshold
-func (s *validatorSeqStore) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
-	tx := s.db.
-		Unscoped().
-		Where("time < ?", purgeThreshold).
-		Delete(&model.ValidatorSeq{})
-
-	if tx.Error != nil {
-		return nil, checkErr(tx.Error)
-	}
-
-	return &tx.RowsAffected, nil
-}
-
 type ValidatorSeqSummary struct {
 	Address                string         `json:"address"`
 	TimeBucket             types.Time     `json:"time_bucket"`
@@ -123,42 +132,37 @@ type ValidatorSeqSummary struct {
 	CommissionAvg          types.Quantity `json:"commission_avg"`
 	CommissionMax          types.Quantity `json:"commission_max"`
 	CommissionMin          types.Quantity `json:"commission_min"`
+	SharePriceAvg          float64        `json:"share_price_avg"`
+	SharePriceMax          float64        `json:"share_price_max"`
+	SharePriceMin          float64        `json:"share_price_min"`
 	ValidatedSum           int64          `json:"validated_sum"`
 	NotValidatedSum        int64          `json:"not_validated_sum"`
 	ProposedSum            int64          `json:"proposed_sum"`
 	UptimeAvg              float64        `json:"uptime_avg"`
 }
 
-// Summarize gets the summarized version of validator sequences
-func (s *validatorSeqStore) Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]ValidatorSeqSummary, error) {
+// FindChangesSince finds validator sequences created after given height
+func (s *validatorSeqStore) FindChangesSince(height int64) (*ChangeSetRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_FindChangesSince"))
+	defer t.ObserveDuration()
+
+	return findChangesSince(s.db, getChangesSinceQuery(model.ValidatorSeq{}.TableName()), height)
+}
+
+// Summarize gets the summarized version of validator sequences for buckets starting from given time
+func (s *validatorSeqStore) Summarize(interval types.SummaryInterval, since time.Time) ([]ValidatorSeqSummary, error) {
 	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_Summarize"))
 	defer t.ObserveDuration()
 
-	tx := s.db.
+	var models []ValidatorSeqSummary
+	err := s.db.
 		Table(model.ValidatorSeq{}.TableName()).
 		Select(summarizeValidatorsQuerySelect, interval).
+		Where("time >= DATE_TRUNC(?, ?::TIMESTAMPTZ)", interval, since).
 		Order("time_bucket").
-		Group("address, time_bucket")
-
-	if len(activityPeriods) == 1 {
-		activityPeriod := activityPeriods[0]
-		tx = tx.Or("time < ? OR time >= ?", activityPeriod.Min, activityPeriod.Max)
-	} else {
-		for i, activityPeriod := range activityPeriods {
-			isLast := i == len(activityPeriods)-1
-
-			if isLast {
-				tx = tx.Or("time >= ?", activityPeriod.Max)
-			} else {
-				duration, err := interval.ToDuration()
-				if err != nil {
-					return nil, err
-				}
-				tx = tx.Or("time >= ? AND time < ?", activityPeriod.Max.Add(duration), activityPeriods[i+1].Min)
-			}
-		}
-	}
+		Group("address, time_bucket").
+		Find(&models).
+		Error
 
-	var models []ValidatorSeqSummary
-	return models, tx.Find(&models).Error
+	return models, err
 }
@@ -46,5 +46,75 @@ WHERE time_bucket >= (
 	AND time_interval = ?
 GROUP BY time_bucket, time_interval
 ORDER BY time_bucket
+`
+
+	validatorUptimeForPeriodQuery = `
+SELECT
+  address,
+  COALESCE(SUM(validated_sum)::DECIMAL / NULLIF(SUM(validated_sum + not_validated_sum), 0), 0) AS uptime
+FROM validator_summary
+WHERE time_interval = ? AND time_bucket >= ? AND time_bucket < ?
+GROUP BY address
+`
+
+	validatorActiveEscrowBalanceForPeriodQuery = `
+SELECT
+  address,
+  ROUND(SUM(active_escrow_balance_avg * (validated_sum + not_validated_sum)) / NULLIF(SUM(validated_sum + not_validated_sum), 0)) AS active_escrow_balance_avg
+FROM validator_summary
+WHERE time_interval = ? AND time_bucket >= ? AND time_bucket < ?
+GROUP BY address
+`
+
+	rollupValidatorSummaryQuery = `
+WITH hourly AS (
+  SELECT *, validated_sum + not_validated_sum AS n
+  FROM validator_summary
+  WHERE time_interval = ? AND index_version = ? AND time_bucket >= DATE_TRUNC(?, ?::TIMESTAMPTZ)
+)
+SELECT
+  address,
+  DATE_TRUNC(?, time_bucket)                                            AS time_bucket,
+  SUM(voting_power_avg * n) / NULLIF(SUM(n), 0)                         AS voting_power_avg,
+  MAX(voting_power_max)                                                 AS voting_power_max,
+  MIN(voting_power_min)                                                 AS voting_power_min,
+  ROUND(SUM(total_shares_avg * n) / NULLIF(SUM(n), 0))                  AS total_shares_avg,
+  MAX(total_shares_max)                                                 AS total_shares_max,
+  MIN(total_shares_min)                                                 AS total_shares_min,
+  ROUND(SUM(active_escrow_balance_avg * n) / NULLIF(SUM(n), 0))         AS active_escrow_balance_avg,
+  MAX(active_escrow_balance_max)                                        AS active_escrow_balance_max,
+  MIN(active_escrow_balance_min)                                        AS active_escrow_balance_min,
+  ROUND(SUM(commission_avg * n) / NULLIF(SUM(n), 0))                    AS commission_avg,
+  MAX(commission_max)                                                   AS commission_max,
+  MIN(commission_min)                                                   AS commission_min,
+  SUM(share_price_avg * n) / NULLIF(SUM(n), 0)                          AS share_price_avg,
+  MAX(share_price_max)                                                  AS share_price_max,
+  MIN(share_price_min)                                                  AS share_price_min,
+  SUM(validated_sum)::DECIMAL / NULLIF(SUM(n), 0)                       AS uptime_avg,
+  SUM(validated_sum)                                                    AS validated_sum,
+  SUM(not_validated_sum)                                                AS not_validated_sum,
+  SUM(proposed_sum)                                                     AS proposed_sum
+FROM hourly
+GROUP BY address, 2
+ORDER BY 2
+`
+
+	backfillValidatorSummarySharePriceQuery = `
+UPDATE validator_summary AS s
+SET share_price_avg = q.share_price_avg,
+    share_price_min = q.share_price_min,
+    share_price_max = q.share_price_max
+FROM (
+  SELECT
+    address,
+    DATE_TRUNC(?, time)                                               AS time_bucket,
+    COALESCE(AVG(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_avg,
+    COALESCE(MIN(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_min,
+    COALESCE(MAX(active_escrow_balance / NULLIF(total_shares, 0)), 0) AS share_price_max
+  FROM validator_sequences
+  WHERE time >= ? AND time < ?
+  GROUP BY address, 2
+) AS q
+WHERE s.time_interval = ? AND s.address = q.address AND s.time_bucket = q.time_bucket
 `
 )
@@ -1,7 +1,6 @@
 package store
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/metrics"
@@ -19,12 +18,14 @@ type ValidatorSummaryStore interface {
 	BaseStore
 
 	Find(*model.ValidatorSummary) (*model.ValidatorSummary, error)
-	FindActivityPeriods(types.SummaryInterval, int64) ([]ActivityPeriodRow, error)
 	FindSummary(types.SummaryInterval, string) ([]ValidatorSummaryRow, error)
 	FindSummaryByAddress(string, types.SummaryInterval, string) ([]model.ValidatorSummary, error)
+	FindUptimeForPeriod(types.SummaryInterval, time.Time, time.Time) ([]ValidatorPeriodUptimeRow, error)
+	FindActiveEscrowBalanceForPeriod(types.SummaryInterval, time.Time, time.Time) ([]ValidatorPeriodBalanceRow, error)
+	Rollup(types.SummaryInterval, types.SummaryInterval, time.Time, int64) ([]ValidatorSeqSummary, error)
 	FindMostRecent() (*model.ValidatorSummary, error)
 	FindMostRecentByInterval(types.SummaryInterval) (*model.ValidatorSummary, error)
-	DeleteOlderThan(types.SummaryInterval, time.Time) (*int64, error)
+	BackfillSharePrice(types.SummaryInterval, time.Time, time.Time) (int64, error)
 }
 
 func NewValidatorSummaryStore(db *gorm.DB) *validatorSummaryStore {
@@ -48,17 +49,6 @@ func (s validatorSummaryStore) Find(query *model.ValidatorSummary) (*model.Valid
 	return &result, checkErr(err)
 }
 
-// FindActivityPeriods Finds activity periods
-func (s *validatorSummaryStore) FindActivityPeriods(interval types.SummaryInterval, indexVersion int64) ([]ActivityPeriodRow, error) {
-	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSummaryStore_FindActivityPeriods"))
-	defer t.ObserveDuration()
-
-	query := getActivityPeriodsQuery(model.ValidatorSummary{}.TableName())
-
-	var res []ActivityPeriodRow
-	return res, s.db.Raw(query, fmt.Sprintf("1%s", interval), interval, indexVersion).Find(&res).Error
-}
-
 type ValidatorSummaryRow struct {
 	TimeBucket             string         `json:"time_bucket"`
 	TimeInterval           string         `json:"time_interval"`
@@ -98,6 +88,44 @@ func (s *validatorSummaryStore) FindSummaryByAddress(address string, interval ty
 	return res, s.db.Raw(validatorSummaryForIntervalQuery, interval, period, address, interval).Find(&res).Error
 }
 
+type ValidatorPeriodUptimeRow struct {
+	Address string  `json:"address"`
+	Uptime  float64 `json:"uptime"`
+}
+
+// FindUptimeForPeriod gets uptime of validators in summaries with time buckets in [start, end)
+func (s *validatorSummaryStore) FindUptimeForPeriod(interval types.SummaryInterval, start, end time.Time) ([]ValidatorPeriodUptimeRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSummaryStore_FindUptimeForPeriod"))
+	defer t.ObserveDuration()
+
+	var res []ValidatorPeriodUptimeRow
+	return res, s.db.Raw(validatorUptimeForPeriodQuery, interval, start, end).Find(&res).Error
+}
+
+type ValidatorPeriodBalanceRow struct {
+	Address                string         `json:"address"`
+	ActiveEscrowBalanceAvg types.Quantity `json:"active_escrow_balance_avg"`
+}
+
+// FindActiveEscrowBalanceForPeriod gets average active escrow balance of validators in summaries with time buckets in [start, end)
+func (s *validatorSummaryStore) FindActiveEscrowBalanceForPeriod(interval types.SummaryInterval, start, end time.Time) ([]ValidatorPeriodBalanceRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSummaryStore_FindActiveEscrowBalanceForPeriod"))
+	defer t.ObserveDuration()
+
+	var res []ValidatorPeriodBalanceRow
+	return res, s.db.Raw(validatorActiveEscrowBalanceForPeriodQuery, interval, start, end).Find(&res).Error
+}
+
+// BackfillSharePrice sets share price of summaries of interval with buckets in [start, end) from validator sequences of their buckets.
+// Range has to be aligned to buckets of interval, so share price of every bucket is computed from all of its sequences
+func (s *validatorSummaryStore) BackfillSharePrice(interval types.SummaryInterval, start, end time.Time) (int64, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSummaryStore_BackfillSharePrice"))
+	defer t.ObserveDuration()
+
+	result := s.db.Exec(backfillValidatorSummarySharePriceQuery, interval, start, end, interval)
+	return result.RowsAffected, result.Error
+}
+
 // FindMostRecent finds most recent validator summary
 func (s *validatorSummaryStore) FindMostRecent() (*model.ValidatorSummary, error) {
 	validatorSummary := &model.ValidatorSummary{}
@@ -121,16 +149,11 @@ func (s *validatorSummaryStore) FindMostRecentByInterval(interval types.SummaryI
 	return &result, checkErr(err)
 }
 
-// DeleteOlderThan deleted validator summary records older than given threshold
-func (s *validatorSummaryStore) DeleteOlderThan(interval types.SummaryInterval, purgeThreshold time.Time) (*int64, error) {
-	statement := s.db.
-		Unscoped().
-		Where("time_interval = ? AND time_bucket < ?", interval, purgeThreshold).
-		Delete(&model.ValidatorSummary{})
-
-	if statement.Error != nil {
-		return nil, checkErr(statement.Error)
-	}
+// Rollup aggregates validator summaries of one interval into buckets of a larger interval starting from given time
+func (s *validatorSummaryStore) Rollup(from, to types.SummaryInterval, since time.Time, indexVersion int64) ([]ValidatorSeqSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSummaryStore_Rollup"))
+	defer t.ObserveDuration()
 
-	return &statement.RowsAffected, nil
+	var res []ValidatorSeqSummary
+	return res, s.db.Raw(rollupValidatorSummaryQuery, from, indexVersion, to, since, to).Find(&res).Error
 }
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ ValidatorUptimesStore = (*validatorUptimesStore)(nil)
+)
+
+type ValidatorUptimesStore interface {
+	BaseStore
+
+	FindByAddresses([]string) ([]model.ValidatorUptime, error)
+}
+
+func NewValidatorUptimesStore(db *gorm.DB) *validatorUptimesStore {
+	return &validatorUptimesStore{scoped(db, model.ValidatorUptime{})}
+}
+
+// validatorUptimesStore handles operations on validator uptimes
+type validatorUptimesStore struct {
+	baseStore
+}
+
+// FindByAddresses returns uptimes of validators with given addresses
+func (s validatorUptimesStore) FindByAddresses(addresses []string) ([]model.ValidatorUptime, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorUptimesStore_FindByAddresses"))
+	defer t.ObserveDuration()
+
+	var result []model.ValidatorUptime
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	err := s.db.
+		Where("address IN (?)", addresses).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
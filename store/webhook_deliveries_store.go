@@ -0,0 +1,76 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ WebhookDeliveriesStore = (*webhookDeliveriesStore)(nil)
+)
+
+type WebhookDeliveriesStore interface {
+	BaseStore
+
+	CreateIfNotExists(*model.WebhookDelivery) error
+	FindPending(time.Time, int64, int64) ([]model.WebhookDelivery, error)
+	FindBySubscription(types.ID, Pagination) ([]model.WebhookDelivery, error)
+}
+
+func NewWebhookDeliveriesStore(db *gorm.DB) *webhookDeliveriesStore {
+	return &webhookDeliveriesStore{scoped(db, model.WebhookDelivery{})}
+}
+
+// webhookDeliveriesStore handles operations on webhook deliveries
+type webhookDeliveriesStore struct {
+	baseStore
+}
+
+// CreateIfNotExists creates a new delivery unless system event is already queued for subscription
+func (s webhookDeliveriesStore) CreateIfNotExists(val *model.WebhookDelivery) error {
+	err := s.db.
+		Set("gorm:insert_option", "ON CONFLICT (subscription_id, system_event_id) DO NOTHING").
+		Create(val).
+		Error
+
+	return checkErr(err)
+}
+
+// FindPending returns pending deliveries which should be attempted before given time.
+// At most perSubscription deliveries of every subscription are returned, so backlog of one subscription does not hold back others
+func (s webhookDeliveriesStore) FindPending(now time.Time, perSubscription int64, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	ranked := s.db.
+		Table(model.WebhookDelivery{}.TableName()).
+		Select("id, ROW_NUMBER() OVER (PARTITION BY subscription_id ORDER BY next_attempt_at, id) AS n").
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookDeliveryPending, now).
+		QueryExpr()
+
+	err := s.db.
+		Where("id IN (SELECT id FROM (?) AS ranked WHERE n <= ?)", ranked, perSubscription).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindBySubscription returns page of deliveries for subscription.
+// Deliveries are ordered by system event id, which is stored in cursor height
+func (s webhookDeliveriesStore) FindBySubscription(subscriptionID types.ID, page Pagination) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	tx := s.db.
+		Where("subscription_id = ?", subscriptionID)
+
+	err := page.paginate(tx, "system_event_id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ WebhookSubscriptionsStore = (*webhookSubscriptionsStore)(nil)
+)
+
+type WebhookSubscriptionsStore interface {
+	BaseStore
+
+	FindAll() ([]model.WebhookSubscription, error)
+	FindByAPIKey(types.ID) ([]model.WebhookSubscription, error)
+	FindByID(types.ID) (*model.WebhookSubscription, error)
+	DeleteByID(types.ID) error
+}
+
+func NewWebhookSubscriptionsStore(db *gorm.DB) *webhookSubscriptionsStore {
+	return &webhookSubscriptionsStore{scoped(db, model.WebhookSubscription{})}
+}
+
+// webhookSubscriptionsStore handles operations on webhook subscriptions
+type webhookSubscriptionsStore struct {
+	baseStore
+}
+
+// FindAll returns all webhook subscriptions
+func (s webhookSubscriptionsStore) FindAll() ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+
+	err := s.db.
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByAPIKey returns webhook subscriptions created by api key
+func (s webhookSubscriptionsStore) FindByAPIKey(apiKeyID types.ID) ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+
+	err := s.db.
+		Where("api_key_id = ?", apiKeyID).
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByID returns webhook subscription by id
+func (s webhookSubscriptionsStore) FindByID(id types.ID) (*model.WebhookSubscription, error) {
+	result := &model.WebhookSubscription{}
+	err := findBy(s.db, result, "id", id)
+	return result, checkErr(err)
+}
+
+// DeleteByID deletes webhook subscription together with its deliveries
+func (s webhookSubscriptionsStore) DeleteByID(id types.ID) error {
+	tx := s.db.
+		Where("id = ?", id).
+		Delete(&model.WebhookSubscription{})
+
+	if tx.Error != nil {
+		return checkErr(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -1,27 +1,67 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 const (
-	IntervalHourly SummaryInterval = "hour"
-	IntervalDaily  SummaryInterval = "day"
+	IntervalHourly  SummaryInterval = "hour"
+	IntervalDaily   SummaryInterval = "day"
+	IntervalWeekly  SummaryInterval = "week"
+	IntervalMonthly SummaryInterval = "month"
+)
+
+var (
+	ErrInvalidSummaryInterval = errors.New("invalid summary interval")
 )
 
 // SummaryInterval type represents summary interval
 type SummaryInterval string
 
 func (s SummaryInterval) Valid() bool {
-	return s == IntervalHourly || s == IntervalDaily
+	return s == IntervalHourly ||
+		s == IntervalDaily ||
+		s == IntervalWeekly ||
+		s == IntervalMonthly
 }
 
 func (s SummaryInterval) Equal(o SummaryInterval) bool {
 	return s == o
 }
 
-func (s SummaryInterval) ToDuration() (time.Duration, error) {
-	if s == IntervalDaily {
-		return time.ParseDuration("24h")
+// ToDuration returns duration of the bucket starting at given time.
+// Monthly buckets follow calendar months so their duration depends on the start of the bucket
+func (s SummaryInterval) ToDuration(start time.Time) (time.Duration, error) {
+	switch s {
+	case IntervalHourly:
+		return time.Hour, nil
+	case IntervalDaily:
+		return start.AddDate(0, 0, 1).Sub(start), nil
+	case IntervalWeekly:
+		return start.AddDate(0, 0, 7).Sub(start), nil
+	case IntervalMonthly:
+		return start.AddDate(0, 1, 0).Sub(start), nil
+	default:
+		return 0, ErrInvalidSummaryInterval
 	}
+}
 
-	return time.ParseDuration("1h")
+// Truncate returns start of the bucket given time belongs to
+func (s SummaryInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch s {
+	case IntervalHourly:
+		return t.Truncate(time.Hour)
+	case IntervalDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case IntervalWeekly:
+		// Weeks start on Monday to match DATE_TRUNC
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case IntervalMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
 }
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummaryInterval_ToDuration(t *testing.T) {
+	tests := []struct {
+		description string
+		interval    SummaryInterval
+		start       time.Time
+		want        time.Duration
+		wantErr     bool
+	}{
+		{"hourly bucket lasts an hour", IntervalHourly, time.Date(2020, 2, 1, 10, 0, 0, 0, time.UTC), time.Hour, false},
+		{"daily bucket lasts a day", IntervalDaily, time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), 24 * time.Hour, false},
+		{"weekly bucket lasts 7 days", IntervalWeekly, time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC), 7 * 24 * time.Hour, false},
+		{"monthly bucket of february in leap year lasts 29 days", IntervalMonthly, time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), 29 * 24 * time.Hour, false},
+		{"monthly bucket of january lasts 31 days", IntervalMonthly, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), 31 * 24 * time.Hour, false},
+		{"monthly bucket of april lasts 30 days", IntervalMonthly, time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC), 30 * 24 * time.Hour, false},
+		{"returns error for invalid interval", SummaryInterval("year"), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := tt.interval.ToDuration(tt.start)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("unexpected duration, want %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSummaryInterval_Truncate(t *testing.T) {
+	at := time.Date(2020, 2, 13, 15, 42, 10, 0, time.UTC)
+
+	tests := []struct {
+		interval SummaryInterval
+		want     time.Time
+	}{
+		{IntervalHourly, time.Date(2020, 2, 13, 15, 0, 0, 0, time.UTC)},
+		{IntervalDaily, time.Date(2020, 2, 13, 0, 0, 0, 0, time.UTC)},
+		{IntervalWeekly, time.Date(2020, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{IntervalMonthly, time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.interval), func(t *testing.T) {
+			if got := tt.interval.Truncate(at); !got.Equal(tt.want) {
+				t.Errorf("unexpected bucket start, want %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
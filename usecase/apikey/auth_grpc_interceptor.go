@@ -0,0 +1,106 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	APIKeyMetadata = "x-api-key"
+)
+
+// AuthGrpcInterceptor authenticates gRPC calls with api keys and limits their rate
+type AuthGrpcInterceptor struct {
+	*authenticator
+}
+
+func NewAuthGrpcInterceptor(cfg *config.Config, db *store.Store) *AuthGrpcInterceptor {
+	return &AuthGrpcInterceptor{
+		authenticator: newAuthenticator(cfg, db),
+	}
+}
+
+// Unary returns interceptor which rejects unary calls without api key of given scope or above rate limit of the key
+func (i *AuthGrpcInterceptor) Unary(scope model.APIKeyScope) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.authorize(ctx, scope); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns interceptor which rejects streaming calls without api key of given scope or above rate limit of the key
+func (i *AuthGrpcInterceptor) Stream(scope model.APIKeyScope) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.authorize(ss.Context(), scope); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize returns status error when call is not allowed
+func (i *AuthGrpcInterceptor) authorize(ctx context.Context, scope model.APIKeyScope) error {
+	cl, err := i.authenticate(ctx, keyFromMetadata(ctx), peerIP(ctx))
+	if err != nil {
+		if err == ErrAPIKeyRequired || err == ErrInvalidAPIKey {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		logger.Error(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if !cl.hasScope(scope) {
+		return status.Error(codes.PermissionDenied, ErrScopeNotAllowed.Error())
+	}
+
+	if _, retryAfter, ok := i.limiter.Allow(cl.id, cl.rateLimit); !ok {
+		return status.Error(codes.ResourceExhausted, fmt.Sprintf("%s, retry after %ds", ErrRateLimitExceeded, int64(math.Ceil(retryAfter.Seconds()))))
+	}
+	return nil
+}
+
+// keyFromMetadata returns key from x-api-key metadata or bearer token of authorization metadata
+func keyFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(APIKeyMetadata); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+
+	if values := md.Get("authorization"); len(values) > 0 && strings.HasPrefix(values[0], "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+	}
+	return ""
+}
+
+// peerIP returns ip address of the caller
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,71 @@
+package apikey
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthGrpcInterceptor_Unary(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         config.Config
+		scope       model.APIKeyScope
+		calls       int
+		code        codes.Code
+	}{
+		{"anonymous access disabled", config.Config{}, model.APIKeyScopeRead, 1, codes.Unauthenticated},
+		{"anonymous scope", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read"}, model.APIKeyScopeRead, 1, codes.OK},
+		{"missing anonymous scope", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read"}, model.APIKeyScopeWrite, 1, codes.PermissionDenied},
+		{"anonymous rate limit", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read", ApiAnonymousRateLimit: 2}, model.APIKeyScopeRead, 3, codes.ResourceExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cfg := tt.cfg
+			interceptor := NewAuthGrpcInterceptor(&cfg, nil).Unary(tt.scope)
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			var err error
+			for i := 0; i < tt.calls; i++ {
+				_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			}
+
+			if code := status.Code(err); code != tt.code {
+				t.Errorf("unexpected code, want %v; got %v", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestKeyFromMetadata(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		want  string
+	}{
+		{APIKeyMetadata, "abc", "abc"},
+		{"authorization", "Bearer abc", "abc"},
+		{"authorization", "Basic abc", ""},
+	}
+
+	for _, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.key, tt.value))
+
+		if key := keyFromMetadata(ctx); key != tt.want {
+			t.Errorf("unexpected key for %s: %s, want %v; got %v", tt.key, tt.value, tt.want, key)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package apikey
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+
+	anonymousClient = "anonymous"
+	invalidClient   = "invalid"
+)
+
+var (
+	ErrAPIKeyRequired    = errors.New("api key is required")
+	ErrScopeNotAllowed   = errors.New("api key is not allowed to access this route")
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+)
+
+// AuthHttpHandler authenticates requests with api keys and limits their rate
+type AuthHttpHandler struct {
+	*authenticator
+}
+
+func NewAuthHttpHandler(cfg *config.Config, db *store.Store) *AuthHttpHandler {
+	return &AuthHttpHandler{
+		authenticator: newAuthenticator(cfg, db),
+	}
+}
+
+// Require returns middleware which rejects requests without api key of given scope or above rate limit of the key
+func (h *AuthHttpHandler) Require(scope model.APIKeyScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := invalidClient
+		defer func() {
+			apiKeyRequests.WithLabels(name, strconv.Itoa(c.Writer.Status())).Inc()
+		}()
+
+		cl, err := h.authenticate(c.Request.Context(), keyFromRequest(c), c.ClientIP())
+		if err != nil {
+			if err == ErrAPIKeyRequired || err == ErrInvalidAPIKey {
+				http.Unauthorized(c, err)
+			} else {
+				http.ShouldReturn(c, err)
+			}
+			return
+		}
+		name = cl.name
+
+		if !cl.hasScope(scope) {
+			http.Forbidden(c, ErrScopeNotAllowed)
+			return
+		}
+
+		remaining, retryAfter, ok := h.limiter.Allow(cl.id, cl.rateLimit)
+		if cl.rateLimit > 0 {
+			c.Header("X-RateLimit-Limit", strconv.FormatInt(cl.rateLimit, 10))
+			c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		}
+		if !ok {
+			c.Header("Retry-After", fmt.Sprint(int64(math.Ceil(retryAfter.Seconds()))))
+			http.TooManyRequests(c, ErrRateLimitExceeded)
+			return
+		}
+
+		if cl.apiKey != nil {
+			http.SetAPIKey(c, cl.apiKey)
+		}
+		c.Next()
+	}
+}
+
+// keyFromRequest returns key from X-API-Key header or bearer token of Authorization header
+func keyFromRequest(c *gin.Context) string {
+	if key := c.GetHeader(APIKeyHeader); key != "" {
+		return key
+	}
+
+	auth := c.GetHeader("Authorization")
+	if strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package apikey
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAuthHttpHandler_Require(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         config.Config
+		scope       model.APIKeyScope
+		requests    int
+		status      int
+	}{
+		{"anonymous access disabled", config.Config{}, model.APIKeyScopeRead, 1, http.StatusUnauthorized},
+		{"anonymous scope", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read"}, model.APIKeyScopeRead, 1, http.StatusOK},
+		{"missing anonymous scope", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read"}, model.APIKeyScopeBroadcast, 1, http.StatusForbidden},
+		{"anonymous rate limit", config.Config{ApiAnonymousAccess: true, ApiAnonymousScopes: "read", ApiAnonymousRateLimit: 2}, model.APIKeyScopeRead, 3, http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			cfg := tt.cfg
+			h := NewAuthHttpHandler(&cfg, nil)
+
+			engine := gin.New()
+			engine.GET("/", h.Require(tt.scope), func(c *gin.Context) {
+				c.String(http.StatusOK, "ok")
+			})
+
+			var w *httptest.ResponseRecorder
+			for i := 0; i < tt.requests; i++ {
+				w = httptest.NewRecorder()
+				engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			}
+
+			if w.Code != tt.status {
+				t.Errorf("unexpected status, want %v; got %v", tt.status, w.Code)
+			}
+		})
+	}
+}
+
+func TestKeyFromRequest(t *testing.T) {
+	tests := []struct {
+		header string
+		value  string
+		key    string
+	}{
+		{APIKeyHeader, "abc", "abc"},
+		{"Authorization", "Bearer abc", "abc"},
+		{"Authorization", "Basic abc", ""},
+	}
+
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		c.Request.Header.Set(tt.header, tt.value)
+
+		if key := keyFromRequest(c); key != tt.key {
+			t.Errorf("unexpected key for %s: %s, want %v; got %v", tt.header, tt.value, tt.key, key)
+		}
+	}
+}
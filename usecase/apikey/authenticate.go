@@ -0,0 +1,72 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/cache"
+	"github.com/pkg/errors"
+)
+
+const (
+	// lookupCacheBytes and lookupCacheTTL limit how much memory cached keys take and for how long they are kept,
+	// so requests don't hit database and revoked keys are rejected shortly after revocation
+	lookupCacheBytes = 4 << 20
+	lookupCacheTTL   = time.Minute
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+type authenticateUseCase struct {
+	db    store.APIKeysStore
+	cache cache.Cache
+}
+
+func NewAuthenticateUseCase(db store.APIKeysStore) *authenticateUseCase {
+	return &authenticateUseCase{
+		db:    db,
+		cache: cache.NewLRU(lookupCacheBytes),
+	}
+}
+
+// Execute returns api key matching the key or ErrInvalidAPIKey when key is unknown or revoked
+func (uc *authenticateUseCase) Execute(ctx context.Context, key string) (*model.APIKey, error) {
+	keyHash := hashKey(key)
+
+	apiKey, err := uc.find(ctx, keyHash)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == nil || apiKey.Revoked() {
+		return nil, ErrInvalidAPIKey
+	}
+	return apiKey, nil
+}
+
+// find returns cached api key or looks it up in database. Unknown keys are cached as nil
+func (uc *authenticateUseCase) find(ctx context.Context, keyHash string) (*model.APIKey, error) {
+	if value, ok, _ := uc.cache.Get(ctx, keyHash); ok {
+		var apiKey *model.APIKey
+		if err := json.Unmarshal(value, &apiKey); err == nil {
+			return apiKey, nil
+		}
+	}
+
+	apiKey, err := uc.db.FindByKeyHash(keyHash)
+	if err == store.ErrNotFound {
+		apiKey, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if value, err := json.Marshal(apiKey); err == nil {
+		uc.cache.Set(ctx, keyHash, value, lookupCacheTTL)
+	}
+	return apiKey, nil
+}
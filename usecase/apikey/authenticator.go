@@ -0,0 +1,87 @@
+package apikey
+
+import (
+	"context"
+	"sync"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+// client is authenticated api key or anonymous client identified by ip address
+type client struct {
+	id        string
+	name      string
+	scopes    []string
+	rateLimit int64
+
+	// apiKey is nil for anonymous client
+	apiKey *model.APIKey
+}
+
+func (c *client) hasScope(scope model.APIKeyScope) bool {
+	for _, s := range c.scopes {
+		if s == scope.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticator identifies clients of HTTP and gRPC APIs and holds their rate limits
+type authenticator struct {
+	cfg *config.Config
+	db  *store.Store
+
+	once    sync.Once
+	useCase *authenticateUseCase
+	limiter *limiter
+}
+
+func newAuthenticator(cfg *config.Config, db *store.Store) *authenticator {
+	return &authenticator{
+		cfg:     cfg,
+		db:      db,
+		limiter: newLimiter(),
+	}
+}
+
+// authenticate returns client of api key or anonymous client with given ip address when anonymous access is enabled
+func (a *authenticator) authenticate(ctx context.Context, key string, ip string) (*client, error) {
+	if key == "" {
+		if !a.cfg.ApiAnonymousAccess {
+			return nil, ErrAPIKeyRequired
+		}
+		return &client{
+			id:        "ip:" + ip,
+			name:      anonymousClient,
+			scopes:    a.cfg.AnonymousScopes(),
+			rateLimit: a.cfg.ApiAnonymousRateLimit,
+		}, nil
+	}
+
+	apiKey, err := a.getUseCase().Execute(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := a.cfg.ApiKeyRateLimit
+	if apiKey.RateLimit != nil {
+		rateLimit = *apiKey.RateLimit
+	}
+	return &client{
+		id:        "key:" + apiKey.Name,
+		name:      apiKey.Name,
+		scopes:    apiKey.Scopes,
+		rateLimit: rateLimit,
+		apiKey:    apiKey,
+	}, nil
+}
+
+func (a *authenticator) getUseCase() *authenticateUseCase {
+	a.once.Do(func() {
+		a.useCase = NewAuthenticateUseCase(a.db.APIKeys)
+	})
+	return a.useCase
+}
@@ -0,0 +1,75 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidName      = errors.New("name is required")
+	ErrInvalidScope     = errors.New("scopes must be read, write or broadcast")
+	ErrInvalidRateLimit = errors.New("rate limit must be positive")
+)
+
+type createUseCase struct {
+	db *store.Store
+}
+
+func NewCreateUseCase(db *store.Store) *createUseCase {
+	return &createUseCase{
+		db: db,
+	}
+}
+
+func (uc *createUseCase) Execute(name string, scopes []string, rateLimit *int64) (*CreatedView, error) {
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	if len(scopes) == 0 {
+		return nil, ErrInvalidScope
+	}
+	for _, scope := range scopes {
+		if !model.APIKeyScope(scope).Valid() {
+			return nil, ErrInvalidScope
+		}
+	}
+	if rateLimit != nil && *rateLimit <= 0 {
+		return nil, ErrInvalidRateLimit
+	}
+
+	key, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := &model.APIKey{
+		Name:      name,
+		KeyHash:   hashKey(key),
+		Scopes:    scopes,
+		RateLimit: rateLimit,
+	}
+	if err := uc.db.APIKeys.Create(apiKey); err != nil {
+		return nil, err
+	}
+
+	return ToCreatedView(apiKey, key), nil
+}
+
+func generateKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashKey returns hash of the key stored in database
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
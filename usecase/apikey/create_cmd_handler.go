@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type CreateCmdHandler struct {
+	cfg *config.Config
+	db  *store.Store
+
+	useCase *createUseCase
+}
+
+func NewCreateCmdHandler(cfg *config.Config, db *store.Store) *CreateCmdHandler {
+	return &CreateCmdHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+// Handle creates api key with comma separated scopes. Default rate limit is used when rateLimit is 0
+func (h *CreateCmdHandler) Handle(ctx context.Context, name string, scopes string, rateLimit int64) {
+	logger.Info("running create api key use case [handler=cmd]")
+
+	var limit *int64
+	if rateLimit != 0 {
+		limit = &rateLimit
+	}
+
+	var scopeList []string
+	for _, scope := range strings.Split(scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopeList = append(scopeList, scope)
+		}
+	}
+
+	created, err := h.getUseCase().Execute(name, scopeList, limit)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	fmt.Println("=== API key ===")
+	fmt.Println("Name:", created.Name)
+	fmt.Println("Scopes:", strings.Join(created.Scopes, ","))
+	if created.RateLimit != nil {
+		fmt.Println("Rate limit:", *created.RateLimit)
+	} else {
+		fmt.Println("Rate limit:", h.cfg.ApiKeyRateLimit, "(default)")
+	}
+	fmt.Println("Key:", created.Key)
+	fmt.Println("")
+	fmt.Println("The key is not stored, save it now")
+}
+
+func (h *CreateCmdHandler) getUseCase() *createUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCreateUseCase(h.db)
+	}
+	return h.useCase
+}
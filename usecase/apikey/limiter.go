@@ -0,0 +1,86 @@
+package apikey
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	sweepInterval = time.Minute
+)
+
+var now = time.Now
+
+// bucket holds tokens of a single client. Bucket is refilled with limit tokens per minute up to limit
+type bucket struct {
+	limit     float64
+	tokens    float64
+	updatedAt time.Time
+}
+
+func (b *bucket) refill(t time.Time) {
+	elapsed := t.Sub(b.updatedAt).Minutes()
+	b.tokens = math.Min(b.limit, b.tokens+elapsed*b.limit)
+	b.updatedAt = t
+}
+
+// limiter is in-memory token bucket rate limiter
+type limiter struct {
+	mu sync.Mutex
+
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+func newLimiter() *limiter {
+	return &limiter{
+		buckets: map[string]*bucket{},
+		sweptAt: now(),
+	}
+}
+
+// Allow takes token from client bucket. It returns remaining tokens and time after which next token is available
+// when request is not allowed. Limit of 0 disables rate limiting
+func (l *limiter) Allow(client string, limit int64) (remaining int64, retryAfter time.Duration, ok bool) {
+	if limit <= 0 {
+		return 0, 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	t := now()
+	l.sweep(t)
+
+	b, exists := l.buckets[client]
+	if !exists {
+		b = &bucket{tokens: float64(limit), updatedAt: t}
+		l.buckets[client] = b
+	}
+	b.limit = float64(limit)
+	b.refill(t)
+
+	if b.tokens < 1 {
+		missing := (1 - b.tokens) / b.limit
+		return 0, time.Duration(missing * float64(time.Minute)), false
+	}
+
+	b.tokens--
+	return int64(b.tokens), 0, true
+}
+
+// sweep removes full buckets, so buckets of inactive clients don't accumulate
+func (l *limiter) sweep(t time.Time) {
+	if t.Sub(l.sweptAt) < sweepInterval {
+		return
+	}
+	l.sweptAt = t
+
+	for client, b := range l.buckets {
+		b.refill(t)
+		if b.tokens >= b.limit {
+			delete(l.buckets, client)
+		}
+	}
+}
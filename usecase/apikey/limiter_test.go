@@ -0,0 +1,77 @@
+package apikey
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	current := time.Now()
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	t.Run("limits requests above limit", func(t *testing.T) {
+		l := newLimiter()
+
+		for i := 0; i < 3; i++ {
+			remaining, _, ok := l.Allow("a", 3)
+			if !ok {
+				t.Fatalf("expected request %d to be allowed", i)
+			}
+			if remaining != int64(2-i) {
+				t.Errorf("unexpected remaining, want %v; got %v", 2-i, remaining)
+			}
+		}
+
+		_, retryAfter, ok := l.Allow("a", 3)
+		if ok {
+			t.Error("expected request to be limited")
+		}
+		if retryAfter < 19*time.Second || retryAfter > 20*time.Second {
+			t.Errorf("unexpected retry after: %v", retryAfter)
+		}
+
+		if _, _, ok := l.Allow("b", 3); !ok {
+			t.Error("expected request of other client to be allowed")
+		}
+	})
+
+	t.Run("refills tokens", func(t *testing.T) {
+		l := newLimiter()
+		l.Allow("a", 1)
+
+		if _, _, ok := l.Allow("a", 1); ok {
+			t.Error("expected request to be limited")
+		}
+
+		current = current.Add(time.Minute)
+		if _, _, ok := l.Allow("a", 1); !ok {
+			t.Error("expected request to be allowed after refill")
+		}
+	})
+
+	t.Run("zero limit disables limiting", func(t *testing.T) {
+		l := newLimiter()
+
+		for i := 0; i < 10; i++ {
+			if _, _, ok := l.Allow("a", 0); !ok {
+				t.Fatal("expected request to be allowed")
+			}
+		}
+		if len(l.buckets) != 0 {
+			t.Errorf("unexpected buckets: %v", l.buckets)
+		}
+	})
+
+	t.Run("sweeps full buckets", func(t *testing.T) {
+		l := newLimiter()
+		l.Allow("a", 10)
+
+		current = current.Add(2 * sweepInterval)
+		l.Allow("b", 10)
+
+		if _, ok := l.buckets["a"]; ok {
+			t.Error("expected bucket of inactive client to be removed")
+		}
+	})
+}
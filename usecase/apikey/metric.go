@@ -0,0 +1,11 @@
+package apikey
+
+import "github.com/figment-networks/indexing-engine/metrics"
+
+var apiKeyRequests = metrics.MustNewCounterWithTags(metrics.Options{
+	Namespace: "indexer",
+	Subsystem: "oasis_http",
+	Name:      "api_key_requests",
+	Desc:      "The total number of http requests by api key and response status",
+	Tags:      []string{"api_key", "status"},
+})
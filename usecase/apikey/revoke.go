@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type revokeUseCase struct {
+	db *store.Store
+}
+
+func NewRevokeUseCase(db *store.Store) *revokeUseCase {
+	return &revokeUseCase{
+		db: db,
+	}
+}
+
+// Execute revokes api key with given name. Revoked keys are rejected once their cached lookup expires
+func (uc *revokeUseCase) Execute(name string) (*model.APIKey, error) {
+	apiKey, err := uc.db.APIKeys.FindByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey.Revoked() {
+		return apiKey, nil
+	}
+
+	apiKey.RevokedAt = types.NewTimeFromTime(time.Now())
+	if err := uc.db.APIKeys.Save(apiKey); err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type RevokeCmdHandler struct {
+	db *store.Store
+
+	useCase *revokeUseCase
+}
+
+func NewRevokeCmdHandler(db *store.Store) *RevokeCmdHandler {
+	return &RevokeCmdHandler{
+		db: db,
+	}
+}
+
+func (h *RevokeCmdHandler) Handle(ctx context.Context, name string) {
+	logger.Info("running revoke api key use case [handler=cmd]")
+
+	apiKey, err := h.getUseCase().Execute(name)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	fmt.Println("Revoked API key:", apiKey.Name)
+}
+
+func (h *RevokeCmdHandler) getUseCase() *revokeUseCase {
+	if h.useCase == nil {
+		h.useCase = NewRevokeUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,19 @@
+package apikey
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+)
+
+// CreatedView includes the key, which is not stored and can't be retrieved later
+type CreatedView struct {
+	*model.APIKey
+
+	Key string `json:"key"`
+}
+
+func ToCreatedView(m *model.APIKey, key string) *CreatedView {
+	return &CreatedView{
+		APIKey: m,
+		Key:    key,
+	}
+}
@@ -16,8 +16,8 @@ func NewGetForAddressUseCase(db *store.Store) *getForAddressUseCase {
 	}
 }
 
-func (uc *getForAddressUseCase) Execute(address string, start, end *types.Time) ([]model.BalanceSummary, error) {
-	summaries, err := uc.db.BalanceSummary.GetDailySummaries(address, start, end)
+func (uc *getForAddressUseCase) Execute(address string, interval types.SummaryInterval, start, end *types.Time) ([]model.BalanceSummary, error) {
+	summaries, err := uc.db.BalanceSummary.GetSummaries(address, interval, start, end)
 	if err != nil {
 		return nil, err
 	}
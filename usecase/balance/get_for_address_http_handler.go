@@ -30,9 +30,10 @@ func NewGetForAddressHttpHandler(db *store.Store, c *client.Client) *getForAddre
 }
 
 type GetForAddressRequest struct {
-	Address string    `uri:"address" binding:"required"`
-	Start   time.Time `form:"start" binding:"-" time_format:"2006-01-02"`
-	End     time.Time `form:"end" binding:"-" time_format:"2006-01-02"`
+	Address  string                `uri:"address" binding:"required"`
+	Interval types.SummaryInterval `form:"interval" binding:"-"`
+	Start    time.Time             `form:"start" binding:"-" time_format:"2006-01-02"`
+	End      time.Time             `form:"end" binding:"-" time_format:"2006-01-02"`
 }
 
 func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
@@ -47,7 +48,15 @@ func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, types.NewTimeFromTime(req.Start), types.NewTimeFromTime(req.End))
+	if req.Interval == "" {
+		req.Interval = types.IntervalDaily
+	}
+	if !req.Interval.Valid() {
+		http.BadRequest(c, types.ErrInvalidSummaryInterval)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, req.Interval, types.NewTimeFromTime(req.Start), types.NewTimeFromTime(req.End))
 	if http.ShouldReturn(c, err) {
 		return
 	}
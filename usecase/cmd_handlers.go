@@ -4,6 +4,7 @@ import (
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/apikey"
 	"github.com/figment-networks/oasishub-indexer/usecase/chain"
 	"github.com/figment-networks/oasishub-indexer/usecase/indexing"
 	"github.com/figment-networks/oasishub-indexer/usecase/validator"
@@ -11,20 +12,28 @@ import (
 
 func NewCmdHandlers(cfg *config.Config, db *store.Store, c *client.Client) *CmdHandlers {
 	return &CmdHandlers{
-		GetStatus:          chain.NewGetStatusCmdHandler(db, c),
-		IndexerIndex:       indexing.NewIndexCmdHandler(cfg, db, c),
-		IndexerBackfill:    indexing.NewBackfillCmdHandler(cfg, db, c),
-		IndexerPurge:       indexing.NewPurgeCmdHandler(cfg, db, c),
-		IndexerSummarize:   indexing.NewSummarizeCmdHandler(cfg, db, c),
-		DecorateValidators: validator.NewDecorateCmdHandler(cfg, db, c),
+		GetStatus:                 chain.NewGetStatusCmdHandler(db, c),
+		IndexerIndex:              indexing.NewIndexCmdHandler(cfg, db, c),
+		IndexerBackfill:           indexing.NewBackfillCmdHandler(cfg, db, c),
+		IndexerPurge:              indexing.NewPurgeCmdHandler(cfg, db, c),
+		IndexerSummarize:          indexing.NewSummarizeCmdHandler(cfg, db, c),
+		IndexerRestore:            indexing.NewRestoreCmdHandler(cfg, db, c),
+		IndexerBackfillSharePrice: indexing.NewBackfillSharePriceCmdHandler(cfg, db, c),
+		DecorateValidators:        validator.NewDecorateCmdHandler(cfg, db, c),
+		CreateAPIKey:              apikey.NewCreateCmdHandler(cfg, db),
+		RevokeAPIKey:              apikey.NewRevokeCmdHandler(db),
 	}
 }
 
 type CmdHandlers struct {
-	GetStatus          *chain.GetStatusCmdHandler
-	IndexerIndex       *indexing.IndexCmdHandler
-	IndexerBackfill    *indexing.BackfillCmdHandler
-	IndexerPurge       *indexing.PurgeCmdHandler
-	IndexerSummarize   *indexing.SummarizeCmdHandler
-	DecorateValidators *validator.DecorateCmdHandler
+	GetStatus                 *chain.GetStatusCmdHandler
+	IndexerIndex              *indexing.IndexCmdHandler
+	IndexerBackfill           *indexing.BackfillCmdHandler
+	IndexerPurge              *indexing.PurgeCmdHandler
+	IndexerSummarize          *indexing.SummarizeCmdHandler
+	IndexerRestore            *indexing.RestoreCmdHandler
+	IndexerBackfillSharePrice *indexing.BackfillSharePriceCmdHandler
+	DecorateValidators        *validator.DecorateCmdHandler
+	CreateAPIKey              *apikey.CreateCmdHandler
+	RevokeAPIKey              *apikey.RevokeCmdHandler
 }
@@ -3,7 +3,6 @@ package debondingdelegation
 import (
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
-	"github.com/pkg/errors"
 )
 
 type getByAddressUseCase struct {
@@ -32,7 +31,7 @@ func (uc *getByAddressUseCase) Execute(address string, height *int64) (*ListView
 	}
 
 	if *height > lastH {
-		return nil, errors.New("height is not indexed yet")
+		return nil, store.ErrHeightNotIndexed
 	}
 
 	res, err := uc.client.DebondingDelegation.GetByAddress(address, *height)
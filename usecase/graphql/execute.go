@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+const (
+	maxQueryDepth = 8
+)
+
+type executeUseCase struct {
+	db *store.Store
+
+	schema *gographql.Schema
+}
+
+func NewExecuteUseCase(db *store.Store) *executeUseCase {
+	return &executeUseCase{
+		db:     db,
+		schema: gographql.MustParseSchema(schema, &rootResolver{db: db}, gographql.UseFieldResolvers(), gographql.MaxDepth(maxQueryDepth)),
+	}
+}
+
+// Execute runs graphql query. Every query gets its own loaders, so records are batched and cached only within the query
+func (uc *executeUseCase) Execute(ctx context.Context, query string, operationName string, variables map[string]interface{}) *gographql.Response {
+	ctx = withLoaders(ctx, newLoaders(uc.db))
+
+	return uc.schema.Exec(ctx, query, operationName, variables)
+}
@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*executeHttpHandler)(nil)
+)
+
+type executeHttpHandler struct {
+	db *store.Store
+
+	useCase *executeUseCase
+}
+
+func NewExecuteHttpHandler(db *store.Store) *executeHttpHandler {
+	return &executeHttpHandler{
+		db: db,
+	}
+}
+
+type Request struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName" binding:"-"`
+	Variables     map[string]interface{} `json:"variables" binding:"-"`
+}
+
+func (h *executeHttpHandler) Handle(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid query"))
+		return
+	}
+
+	resp := h.getUseCase().Execute(c.Request.Context(), req.Query, req.OperationName, req.Variables)
+
+	http.JsonOK(c, resp)
+}
+
+func (h *executeHttpHandler) getUseCase() *executeUseCase {
+	if h.useCase == nil {
+		h.useCase = NewExecuteUseCase(h.db)
+	}
+	return h.useCase
+}
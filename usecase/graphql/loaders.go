@@ -0,0 +1,235 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type loadersKey struct{}
+
+// withLoaders returns context holding loaders of single request
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// loadersFrom returns loaders of the request
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// batchFunc returns values of given keys. Keys without value can be missing from the result
+type batchFunc func(keys []string) (map[string]interface{}, error)
+
+// keySet is an ordered set of keys
+type keySet struct {
+	mu    sync.Mutex
+	keys  []string
+	index map[string]bool
+}
+
+func newKeySet() *keySet {
+	return &keySet{index: make(map[string]bool)}
+}
+
+// Add adds keys which are not in the set yet
+func (s *keySet) Add(keys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		if !s.index[key] {
+			s.index[key] = true
+			s.keys = append(s.keys, key)
+		}
+	}
+}
+
+// List returns keys in order they were added
+func (s *keySet) List() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.keys...)
+}
+
+// loader batches loads of keys within single request.
+// Parent resolvers prime keys of listed records before their fields are resolved, so the first load
+// fetches values of all primed keys at once and list of N records does not need N queries
+type loader struct {
+	batch  batchFunc
+	primed *keySet
+
+	mu      sync.Mutex
+	results map[string]interface{}
+}
+
+func newLoader(batch batchFunc, primed *keySet) *loader {
+	return &loader{
+		batch:   batch,
+		primed:  primed,
+		results: make(map[string]interface{}),
+	}
+}
+
+// Load returns value of given key. Value is fetched together with values of all primed keys which were not loaded yet
+func (l *loader) Load(key string) (interface{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if value, ok := l.results[key]; ok {
+		return value, nil
+	}
+
+	keys := []string{key}
+	for _, primedKey := range l.primed.List() {
+		if _, ok := l.results[primedKey]; !ok && primedKey != key {
+			keys = append(keys, primedKey)
+		}
+	}
+
+	values, err := l.batch(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range keys {
+		l.results[k] = values[k]
+	}
+	return l.results[key], nil
+}
+
+// loaders holds loaders of single request.
+// Loaders of address related records share primed addresses of validators listed in the request
+type loaders struct {
+	db        *store.Store
+	addresses *keySet
+
+	mu     sync.Mutex
+	byName map[string]*loader
+}
+
+func newLoaders(db *store.Store) *loaders {
+	return &loaders{
+		db:        db,
+		addresses: newKeySet(),
+		byName:    make(map[string]*loader),
+	}
+}
+
+// PrimeAddresses registers addresses which are going to be loaded
+func (l *loaders) PrimeAddresses(addresses ...string) {
+	l.addresses.Add(addresses...)
+}
+
+// ValidatorSequences returns up to limit most recent validator sequences of address
+func (l *loaders) ValidatorSequences(address string, limit int64) ([]model.ValidatorSeq, error) {
+	value, err := l.get(fmt.Sprintf("validator_sequences:%d", limit), func(addresses []string) (map[string]interface{}, error) {
+		sequences, err := l.db.ValidatorSeq.FindLastByAddresses(addresses, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{})
+		for _, sequence := range sequences {
+			list, _ := values[sequence.Address].([]model.ValidatorSeq)
+			values[sequence.Address] = append(list, sequence)
+		}
+		return values, nil
+	}).Load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences, _ := value.([]model.ValidatorSeq)
+	return sequences, nil
+}
+
+// SystemEvents returns up to limit most recent system events of address
+func (l *loaders) SystemEvents(address string, kind *model.SystemEventKind, limit int64) ([]model.SystemEvent, error) {
+	name := fmt.Sprintf("system_events:%d", limit)
+	if kind != nil {
+		name = fmt.Sprintf("%s:%s", name, *kind)
+	}
+
+	value, err := l.get(name, func(addresses []string) (map[string]interface{}, error) {
+		systemEvents, err := l.db.SystemEvents.FindLastByActors(addresses, kind, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{})
+		for _, systemEvent := range systemEvents {
+			list, _ := values[systemEvent.Actor].([]model.SystemEvent)
+			values[systemEvent.Actor] = append(list, systemEvent)
+		}
+		return values, nil
+	}).Load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	systemEvents, _ := value.([]model.SystemEvent)
+	return systemEvents, nil
+}
+
+// BalanceEvents returns up to limit most recent balance events of address
+func (l *loaders) BalanceEvents(address string, limit int64) ([]model.BalanceEvent, error) {
+	value, err := l.get(fmt.Sprintf("balance_events:%d", limit), func(addresses []string) (map[string]interface{}, error) {
+		balanceEvents, err := l.db.BalanceEvents.FindLastByAddresses(addresses, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{})
+		for _, balanceEvent := range balanceEvents {
+			list, _ := values[balanceEvent.Address].([]model.BalanceEvent)
+			values[balanceEvent.Address] = append(list, balanceEvent)
+		}
+		return values, nil
+	}).Load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceEvents, _ := value.([]model.BalanceEvent)
+	return balanceEvents, nil
+}
+
+// Account returns account of address, nil when account is not found
+func (l *loaders) Account(address string) (*model.AccountAgg, error) {
+	value, err := l.get("accounts", func(addresses []string) (map[string]interface{}, error) {
+		accounts, err := l.db.AccountAgg.FindByPublicKeys(addresses)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]interface{})
+		for i := range accounts {
+			values[accounts[i].PublicKey] = &accounts[i]
+		}
+		return values, nil
+	}).Load(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, _ := value.(*model.AccountAgg)
+	return account, nil
+}
+
+// get returns loader of given name, creating it when it is requested for the first time
+func (l *loaders) get(name string, batch batchFunc) *loader {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ldr, ok := l.byName[name]
+	if !ok {
+		ldr = newLoader(batch, l.addresses)
+		l.byName[name] = ldr
+	}
+	return ldr
+}
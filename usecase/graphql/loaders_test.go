@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestLoader_Load(t *testing.T) {
+	t.Run("loads primed keys in single batch", func(t *testing.T) {
+		var batches [][]string
+		primed := newKeySet()
+		primed.Add("a", "b", "c")
+
+		ldr := newLoader(func(keys []string) (map[string]interface{}, error) {
+			batches = append(batches, keys)
+			return map[string]interface{}{"a": 1, "c": 3}, nil
+		}, primed)
+
+		for _, key := range []string{"b", "a", "c", "b"} {
+			if _, err := ldr.Load(key); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		expectedBatches := [][]string{{"b", "a", "c"}}
+		if !reflect.DeepEqual(batches, expectedBatches) {
+			t.Errorf("unexpected batches, want %v; got %v", expectedBatches, batches)
+		}
+
+		value, _ := ldr.Load("c")
+		if value != 3 {
+			t.Errorf("unexpected value, want %v; got %v", 3, value)
+		}
+		value, _ = ldr.Load("b")
+		if value != nil {
+			t.Errorf("unexpected value, want %v; got %v", nil, value)
+		}
+	})
+
+	t.Run("loads key which was not primed", func(t *testing.T) {
+		var batches [][]string
+		primed := newKeySet()
+		primed.Add("a")
+
+		ldr := newLoader(func(keys []string) (map[string]interface{}, error) {
+			batches = append(batches, keys)
+			return map[string]interface{}{}, nil
+		}, primed)
+
+		ldr.Load("a")
+		ldr.Load("z")
+
+		expectedBatches := [][]string{{"a"}, {"z"}}
+		if !reflect.DeepEqual(batches, expectedBatches) {
+			t.Errorf("unexpected batches, want %v; got %v", expectedBatches, batches)
+		}
+	})
+
+	t.Run("does not cache failed batch", func(t *testing.T) {
+		calls := 0
+		ldr := newLoader(func(keys []string) (map[string]interface{}, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("test error")
+			}
+			return map[string]interface{}{"a": 1}, nil
+		}, newKeySet())
+
+		if _, err := ldr.Load("a"); err == nil {
+			t.Fatal("expected error")
+		}
+
+		value, err := ldr.Load("a")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value != 1 {
+			t.Errorf("unexpected value, want %v; got %v", 1, value)
+		}
+	})
+}
+
+func TestLoaders_ValidatorSequences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorSeqStoreMock := mock_store.NewMockValidatorSeqStore(ctrl)
+	validatorSeqStoreMock.EXPECT().FindLastByAddresses([]string{"address1", "address2", "address3"}, int64(2)).Return([]model.ValidatorSeq{
+		{Address: "address1", VotingPower: 10},
+		{Address: "address1", VotingPower: 9},
+		{Address: "address3", VotingPower: 5},
+	}, nil).Times(1)
+
+	l := newLoaders(&store.Store{ValidatorSeq: validatorSeqStoreMock})
+	l.PrimeAddresses("address1", "address2", "address3")
+
+	expectedCounts := map[string]int{"address1": 2, "address2": 0, "address3": 1}
+	for _, address := range []string{"address1", "address2", "address3"} {
+		sequences, err := l.ValidatorSequences(address, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(sequences) != expectedCounts[address] {
+			t.Errorf("unexpected sequence count for %s, want %v; got %v", address, expectedCounts[address], len(sequences))
+		}
+		for _, sequence := range sequences {
+			if sequence.Address != address {
+				t.Errorf("unexpected sequence address, want %v; got %v", address, sequence.Address)
+			}
+		}
+	}
+}
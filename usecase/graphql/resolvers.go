@@ -0,0 +1,270 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+const (
+	defaultNestedLimit = 10
+)
+
+var (
+	ErrInvalidInterval = errors.New("invalid interval")
+	ErrInvalidLimit    = errors.New("invalid limit")
+)
+
+type rootResolver struct {
+	db *store.Store
+}
+
+func (r *rootResolver) Block(args struct{ Height *int32 }) (*BlockView, error) {
+	var block *model.BlockSeq
+	var err error
+	if args.Height == nil {
+		block, err = r.db.BlockSeq.FindMostRecent()
+	} else {
+		block, err = r.db.BlockSeq.FindByHeight(int64(*args.Height))
+	}
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return ToBlockView(block), nil
+}
+
+func (r *rootResolver) BlocksSummary(args struct {
+	Interval string
+	Period   string
+}) ([]*BlockSummaryView, error) {
+	interval := types.SummaryInterval(args.Interval)
+	if !interval.Valid() {
+		return nil, ErrInvalidInterval
+	}
+
+	summaries, err := r.db.BlockSummary.FindSummary(interval, args.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToBlockSummaryViews(summaries), nil
+}
+
+func (r *rootResolver) Validator(ctx context.Context, args struct{ Address string }) (*validatorResolver, error) {
+	validatorAgg, err := r.db.ValidatorAgg.FindByAddress(args.Address)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	loadersFrom(ctx).PrimeAddresses(validatorAgg.Address)
+
+	return &validatorResolver{ValidatorView: ToValidatorView(validatorAgg)}, nil
+}
+
+func (r *rootResolver) Validators(ctx context.Context, args struct{ Height *int32 }) ([]*validatorResolver, error) {
+	var height int64
+	if args.Height == nil {
+		mostRecent, err := r.db.ValidatorSeq.FindMostRecent()
+		if err != nil {
+			if err == store.ErrNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		height = mostRecent.Height
+	} else {
+		height = int64(*args.Height)
+	}
+
+	sequences, err := r.db.ValidatorSeq.FindByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, len(sequences))
+	for i, sequence := range sequences {
+		addresses[i] = sequence.Address
+	}
+
+	validatorAggs, err := r.db.ValidatorAgg.FindByAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	aggsByAddress := make(map[string]*model.ValidatorAgg)
+	for i := range validatorAggs {
+		aggsByAddress[validatorAggs[i].Address] = &validatorAggs[i]
+	}
+
+	loadersFrom(ctx).PrimeAddresses(addresses...)
+
+	resolvers := make([]*validatorResolver, len(sequences))
+	for i := range sequences {
+		agg, ok := aggsByAddress[sequences[i].Address]
+		if !ok {
+			agg = &model.ValidatorAgg{Address: sequences[i].Address, EntityUID: sequences[i].EntityUID}
+		}
+		resolvers[i] = &validatorResolver{ValidatorView: ToValidatorView(agg), seq: &sequences[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *rootResolver) ValidatorsSummary(args struct {
+	Interval string
+	Period   string
+	Address  *string
+}) ([]*ValidatorSummaryView, error) {
+	interval := types.SummaryInterval(args.Interval)
+	if !interval.Valid() {
+		return nil, ErrInvalidInterval
+	}
+
+	if args.Address == nil {
+		rows, err := r.db.ValidatorSummary.FindSummary(interval, args.Period)
+		if err != nil {
+			return nil, err
+		}
+		return ToValidatorSummaryRowViews(rows), nil
+	}
+
+	summaries, err := r.db.ValidatorSummary.FindSummaryByAddress(*args.Address, interval, args.Period)
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorSummaryViews(summaries), nil
+}
+
+func (r *rootResolver) SystemEvents(ctx context.Context, args struct {
+	Address string
+	Kind    *string
+	Limit   *int32
+}) ([]*SystemEventView, error) {
+	limit, err := getLimit(args.Limit, http.DefaultPageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	systemEvents, err := loadersFrom(ctx).SystemEvents(args.Address, toSystemEventKind(args.Kind), limit)
+	if err != nil {
+		return nil, err
+	}
+	return ToSystemEventViews(systemEvents), nil
+}
+
+func (r *rootResolver) BalanceEvents(ctx context.Context, args struct {
+	Address string
+	Limit   *int32
+}) ([]*BalanceEventView, error) {
+	limit, err := getLimit(args.Limit, http.DefaultPageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceEvents, err := loadersFrom(ctx).BalanceEvents(args.Address, limit)
+	if err != nil {
+		return nil, err
+	}
+	return ToBalanceEventViews(balanceEvents), nil
+}
+
+func (r *rootResolver) Account(ctx context.Context, args struct{ Address string }) (*AccountView, error) {
+	account, err := loadersFrom(ctx).Account(args.Address)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	return ToAccountView(account), nil
+}
+
+// validatorResolver resolves validator fields and loads records related to validator in batches
+type validatorResolver struct {
+	ValidatorView
+
+	seq *model.ValidatorSeq
+}
+
+func (r *validatorResolver) Sequence() *ValidatorSeqView {
+	if r.seq == nil {
+		return nil
+	}
+	return ToValidatorSeqView(r.seq)
+}
+
+func (r *validatorResolver) Sequences(ctx context.Context, args struct{ Limit *int32 }) ([]*ValidatorSeqView, error) {
+	limit, err := getLimit(args.Limit, defaultNestedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences, err := loadersFrom(ctx).ValidatorSequences(r.Address, limit)
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorSeqViews(sequences), nil
+}
+
+func (r *validatorResolver) SystemEvents(ctx context.Context, args struct {
+	Kind  *string
+	Limit *int32
+}) ([]*SystemEventView, error) {
+	limit, err := getLimit(args.Limit, defaultNestedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	systemEvents, err := loadersFrom(ctx).SystemEvents(r.Address, toSystemEventKind(args.Kind), limit)
+	if err != nil {
+		return nil, err
+	}
+	return ToSystemEventViews(systemEvents), nil
+}
+
+func (r *validatorResolver) BalanceEvents(ctx context.Context, args struct{ Limit *int32 }) ([]*BalanceEventView, error) {
+	limit, err := getLimit(args.Limit, defaultNestedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceEvents, err := loadersFrom(ctx).BalanceEvents(r.Address, limit)
+	if err != nil {
+		return nil, err
+	}
+	return ToBalanceEventViews(balanceEvents), nil
+}
+
+func (r *validatorResolver) Account(ctx context.Context) (*AccountView, error) {
+	account, err := loadersFrom(ctx).Account(r.Address)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	return ToAccountView(account), nil
+}
+
+func toSystemEventKind(kind *string) *model.SystemEventKind {
+	if kind == nil {
+		return nil
+	}
+	systemEventKind := model.SystemEventKind(*kind)
+	return &systemEventKind
+}
+
+// getLimit returns limit argument or default limit when it is not provided
+func getLimit(limit *int32, defaultLimit int64) (int64, error) {
+	if limit == nil {
+		return defaultLimit, nil
+	}
+	if *limit <= 0 || int64(*limit) > http.MaxPageLimit {
+		return 0, ErrInvalidLimit
+	}
+	return int64(*limit), nil
+}
@@ -0,0 +1,127 @@
+package graphql
+
+// schema describes queries served by graphql endpoint.
+// Quantities are exact decimal strings, times are RFC 3339 strings and system event data is JSON encoded
+const schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# Block at given height, most recent block when height is omitted
+	block(height: Int): Block
+	blocksSummary(interval: String!, period: String!): [BlockSummary!]!
+
+	validator(address: String!): Validator
+	# Validators at given height, validators at most recent height when height is omitted
+	validators(height: Int): [Validator!]!
+	# Summary of all validators, or summary of single validator when address is provided
+	validatorsSummary(interval: String!, period: String!, address: String): [ValidatorSummary!]!
+
+	systemEvents(address: String!, kind: String, limit: Int = 100): [SystemEvent!]!
+	balanceEvents(address: String!, limit: Int = 100): [BalanceEvent!]!
+	account(address: String!): Account
+}
+
+type Block {
+	height: Int!
+	time: String!
+	transactionsCount: Int!
+}
+
+type BlockSummary {
+	timeInterval: String!
+	timeBucket: String!
+	count: Int!
+	blockTimeAvg: Float!
+}
+
+type Validator {
+	address: String!
+	entityUid: String!
+	entityName: String!
+	logoUrl: String!
+	recentTendermintAddress: String!
+	recentNodeId: String!
+	recentVotingPower: Float!
+	recentTotalShares: String!
+	recentActiveEscrowBalance: String!
+	recentCommission: String!
+	recentRewards: String!
+	recentAsValidatorHeight: Int!
+	recentProposedHeight: Int!
+	accumulatedProposedCount: Int!
+	uptime: Float!
+
+	# Sequence at queried height, null when validator is not listed by height
+	sequence: ValidatorSequence
+	sequences(limit: Int = 10): [ValidatorSequence!]!
+	systemEvents(kind: String, limit: Int = 10): [SystemEvent!]!
+	balanceEvents(limit: Int = 10): [BalanceEvent!]!
+	account: Account
+}
+
+type ValidatorSequence {
+	height: Int!
+	time: String!
+	address: String!
+	entityUid: String!
+	proposed: Boolean!
+	votingPower: Float!
+	totalShares: String!
+	activeEscrowBalance: String!
+	commission: String!
+	rewards: String!
+	precommitValidated: Boolean
+}
+
+type ValidatorSummary {
+	address: String
+	timeInterval: String!
+	timeBucket: String!
+	votingPowerAvg: Float!
+	votingPowerMax: Float!
+	votingPowerMin: Float!
+	totalSharesAvg: String!
+	totalSharesMax: String!
+	totalSharesMin: String!
+	activeEscrowBalanceAvg: String!
+	activeEscrowBalanceMax: String!
+	activeEscrowBalanceMin: String!
+	commissionAvg: String!
+	commissionMax: String!
+	commissionMin: String!
+	validatedSum: Int!
+	notValidatedSum: Int!
+	proposedSum: Int!
+	uptimeAvg: Float!
+}
+
+type SystemEvent {
+	id: ID!
+	height: Int!
+	time: String!
+	actor: String!
+	kind: String!
+	data: String!
+}
+
+type BalanceEvent {
+	height: Int!
+	address: String!
+	escrowAddress: String!
+	amount: String!
+	kind: String!
+}
+
+type Account {
+	publicKey: String!
+	recentAtHeight: Int!
+	generalBalance: String!
+	generalNonce: Float!
+	escrowActiveBalance: String!
+	escrowActiveTotalShares: String!
+	escrowDebondingBalance: String!
+	escrowDebondingTotalShares: String!
+}
+`
@@ -0,0 +1,277 @@
+package graphql
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+type BlockView struct {
+	Height            int32
+	Time              string
+	TransactionsCount int32
+}
+
+func ToBlockView(m *model.BlockSeq) *BlockView {
+	return &BlockView{
+		Height:            int32(m.Height),
+		Time:              formatTime(m.Time),
+		TransactionsCount: int32(m.TransactionsCount),
+	}
+}
+
+type BlockSummaryView struct {
+	TimeInterval string
+	TimeBucket   string
+	Count        int32
+	BlockTimeAvg float64
+}
+
+func ToBlockSummaryViews(ms []model.BlockSummary) []*BlockSummaryView {
+	views := make([]*BlockSummaryView, len(ms))
+	for i, m := range ms {
+		views[i] = &BlockSummaryView{
+			TimeInterval: string(m.TimeInterval),
+			TimeBucket:   formatTime(m.TimeBucket),
+			Count:        int32(m.Count),
+			BlockTimeAvg: m.BlockTimeAvg,
+		}
+	}
+	return views
+}
+
+type ValidatorView struct {
+	Address                   string
+	EntityUID                 string
+	EntityName                string
+	LogoURL                   string
+	RecentTendermintAddress   string
+	RecentNodeID              string
+	RecentVotingPower         float64
+	RecentTotalShares         string
+	RecentActiveEscrowBalance string
+	RecentCommission          string
+	RecentRewards             string
+	RecentAsValidatorHeight   int32
+	RecentProposedHeight      int32
+	AccumulatedProposedCount  int32
+	Uptime                    float64
+}
+
+func ToValidatorView(m *model.ValidatorAgg) ValidatorView {
+	var uptime float64
+	if m.AccumulatedUptimeCount > 0 {
+		uptime = float64(m.AccumulatedUptime) / float64(m.AccumulatedUptimeCount)
+	}
+
+	return ValidatorView{
+		Address:                   m.Address,
+		EntityUID:                 m.EntityUID,
+		EntityName:                m.EntityName,
+		LogoURL:                   m.LogoURL,
+		RecentTendermintAddress:   m.RecentTendermintAddress,
+		RecentNodeID:              m.RecentNodeID,
+		RecentVotingPower:         float64(m.RecentVotingPower),
+		RecentTotalShares:         m.RecentTotalShares.String(),
+		RecentActiveEscrowBalance: m.RecentActiveEscrowBalance.String(),
+		RecentCommission:          m.RecentCommission.String(),
+		RecentRewards:             m.RecentRewards.String(),
+		RecentAsValidatorHeight:   int32(m.RecentAsValidatorHeight),
+		RecentProposedHeight:      int32(m.RecentProposedHeight),
+		AccumulatedProposedCount:  int32(m.AccumulatedProposedCount),
+		Uptime:                    uptime,
+	}
+}
+
+type ValidatorSeqView struct {
+	Height              int32
+	Time                string
+	Address             string
+	EntityUID           string
+	Proposed            bool
+	VotingPower         float64
+	TotalShares         string
+	ActiveEscrowBalance string
+	Commission          string
+	Rewards             string
+	PrecommitValidated  *bool
+}
+
+func ToValidatorSeqView(m *model.ValidatorSeq) *ValidatorSeqView {
+	return &ValidatorSeqView{
+		Height:              int32(m.Height),
+		Time:                formatTime(m.Time),
+		Address:             m.Address,
+		EntityUID:           m.EntityUID,
+		Proposed:            m.Proposed,
+		VotingPower:         float64(m.VotingPower),
+		TotalShares:         m.TotalShares.String(),
+		ActiveEscrowBalance: m.ActiveEscrowBalance.String(),
+		Commission:          m.Commission.String(),
+		Rewards:             m.Rewards.String(),
+		PrecommitValidated:  m.PrecommitValidated,
+	}
+}
+
+func ToValidatorSeqViews(ms []model.ValidatorSeq) []*ValidatorSeqView {
+	views := make([]*ValidatorSeqView, len(ms))
+	for i := range ms {
+		views[i] = ToValidatorSeqView(&ms[i])
+	}
+	return views
+}
+
+type ValidatorSummaryView struct {
+	Address                *string
+	TimeInterval           string
+	TimeBucket             string
+	VotingPowerAvg         float64
+	VotingPowerMax         float64
+	VotingPowerMin         float64
+	TotalSharesAvg         string
+	TotalSharesMax         string
+	TotalSharesMin         string
+	ActiveEscrowBalanceAvg string
+	ActiveEscrowBalanceMax string
+	ActiveEscrowBalanceMin string
+	CommissionAvg          string
+	CommissionMax          string
+	CommissionMin          string
+	ValidatedSum           int32
+	NotValidatedSum        int32
+	ProposedSum            int32
+	UptimeAvg              float64
+}
+
+func ToValidatorSummaryRowViews(rows []store.ValidatorSummaryRow) []*ValidatorSummaryView {
+	views := make([]*ValidatorSummaryView, len(rows))
+	for i, row := range rows {
+		views[i] = &ValidatorSummaryView{
+			TimeInterval:           row.TimeInterval,
+			TimeBucket:             row.TimeBucket,
+			VotingPowerAvg:         row.VotingPowerAvg,
+			VotingPowerMax:         row.VotingPowerMax,
+			VotingPowerMin:         row.VotingPowerMin,
+			TotalSharesAvg:         row.TotalSharesAvg.String(),
+			TotalSharesMax:         row.TotalSharesMax.String(),
+			TotalSharesMin:         row.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: row.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: row.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: row.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          row.CommissionAvg.String(),
+			CommissionMax:          row.CommissionMax.String(),
+			CommissionMin:          row.CommissionMin.String(),
+			ValidatedSum:           int32(row.ValidatedSum),
+			NotValidatedSum:        int32(row.NotValidatedSum),
+			ProposedSum:            int32(row.ProposedSum),
+			UptimeAvg:              row.UptimeAvg,
+		}
+	}
+	return views
+}
+
+func ToValidatorSummaryViews(ms []model.ValidatorSummary) []*ValidatorSummaryView {
+	views := make([]*ValidatorSummaryView, len(ms))
+	for i, m := range ms {
+		views[i] = &ValidatorSummaryView{
+			Address:                &ms[i].Address,
+			TimeInterval:           string(m.TimeInterval),
+			TimeBucket:             formatTime(m.TimeBucket),
+			VotingPowerAvg:         m.VotingPowerAvg,
+			VotingPowerMax:         m.VotingPowerMax,
+			VotingPowerMin:         m.VotingPowerMin,
+			TotalSharesAvg:         m.TotalSharesAvg.String(),
+			TotalSharesMax:         m.TotalSharesMax.String(),
+			TotalSharesMin:         m.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: m.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: m.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: m.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          m.CommissionAvg.String(),
+			CommissionMax:          m.CommissionMax.String(),
+			CommissionMin:          m.CommissionMin.String(),
+			ValidatedSum:           int32(m.ValidatedSum),
+			NotValidatedSum:        int32(m.NotValidatedSum),
+			ProposedSum:            int32(m.ProposedSum),
+			UptimeAvg:              m.UptimeAvg,
+		}
+	}
+	return views
+}
+
+type SystemEventView struct {
+	ID     gographql.ID
+	Height int32
+	Time   string
+	Actor  string
+	Kind   string
+	Data   string
+}
+
+func ToSystemEventViews(ms []model.SystemEvent) []*SystemEventView {
+	views := make([]*SystemEventView, len(ms))
+	for i, m := range ms {
+		views[i] = &SystemEventView{
+			ID:     gographql.ID(strconv.FormatInt(int64(m.ID), 10)),
+			Height: int32(m.Height),
+			Time:   formatTime(m.Time),
+			Actor:  m.Actor,
+			Kind:   m.Kind.String(),
+			Data:   string(m.Data.RawMessage),
+		}
+	}
+	return views
+}
+
+type BalanceEventView struct {
+	Height        int32
+	Address       string
+	EscrowAddress string
+	Amount        string
+	Kind          string
+}
+
+func ToBalanceEventViews(ms []model.BalanceEvent) []*BalanceEventView {
+	views := make([]*BalanceEventView, len(ms))
+	for i, m := range ms {
+		views[i] = &BalanceEventView{
+			Height:        int32(m.Height),
+			Address:       m.Address,
+			EscrowAddress: m.EscrowAddress,
+			Amount:        m.Amount.String(),
+			Kind:          m.Kind.String(),
+		}
+	}
+	return views
+}
+
+type AccountView struct {
+	PublicKey                  string
+	RecentAtHeight             int32
+	GeneralBalance             string
+	GeneralNonce               float64
+	EscrowActiveBalance        string
+	EscrowActiveTotalShares    string
+	EscrowDebondingBalance     string
+	EscrowDebondingTotalShares string
+}
+
+func ToAccountView(m *model.AccountAgg) *AccountView {
+	return &AccountView{
+		PublicKey:                  m.PublicKey,
+		RecentAtHeight:             int32(m.RecentAtHeight),
+		GeneralBalance:             m.RecentGeneralBalance.String(),
+		GeneralNonce:               float64(m.RecentGeneralNonce),
+		EscrowActiveBalance:        m.RecentEscrowActiveBalance.String(),
+		EscrowActiveTotalShares:    m.RecentEscrowActiveTotalShares.String(),
+		EscrowDebondingBalance:     m.RecentEscrowDebondingBalance.String(),
+		EscrowDebondingTotalShares: m.RecentEscrowDebondingTotalShares.String(),
+	}
+}
+
+func formatTime(t types.Time) string {
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,301 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/grpc/indexer/indexerpb"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/balance"
+	"github.com/figment-networks/oasishub-indexer/usecase/block"
+	"github.com/figment-networks/oasishub-indexer/usecase/chain"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dateFormat = "2006-01-02"
+)
+
+var (
+	_ indexerpb.IndexerServiceServer = (*indexerServer)(nil)
+
+	ErrInvalidBlockTimesLimit = errors.New("invalid limit")
+	ErrInvalidSequencesLimit  = errors.New("invalid sequences limit")
+	ErrInvalidDate            = errors.New("invalid start or/and end")
+)
+
+// indexerServer serves IndexerService queries using the same use cases as HTTP handlers
+type indexerServer struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewIndexerServer(cfg *config.Config, db *store.Store, c *client.Client) *indexerServer {
+	return &indexerServer{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (s *indexerServer) GetStatus(ctx context.Context, _ *indexerpb.GetStatusRequest) (*indexerpb.GetStatusResponse, error) {
+	resp, err := chain.NewGetStatusUseCase(s.db, s.client).Execute(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return ToStatusResponse(resp), nil
+}
+
+func (s *indexerServer) GetBlockByHeight(_ context.Context, req *indexerpb.GetBlockByHeightRequest) (*indexerpb.GetBlockByHeightResponse, error) {
+	resp, err := block.NewGetByHeightUseCase(s.db, s.client).Execute(toHeight(req.GetHeight()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &indexerpb.GetBlockByHeightResponse{Block: ToBlock(resp)}, nil
+}
+
+func (s *indexerServer) GetBlockTimes(_ context.Context, req *indexerpb.GetBlockTimesRequest) (*indexerpb.GetBlockTimesResponse, error) {
+	if req.GetLimit() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidBlockTimesLimit.Error())
+	}
+
+	resp, err := block.NewGetBlockTimesUseCase(s.db).Execute(req.GetLimit())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return ToBlockTimesResponse(resp), nil
+}
+
+func (s *indexerServer) GetBlockSummary(_ context.Context, req *indexerpb.GetBlockSummaryRequest) (*indexerpb.GetBlockSummaryResponse, error) {
+	interval := types.SummaryInterval(req.GetInterval())
+	if !interval.Valid() {
+		return nil, status.Error(codes.InvalidArgument, types.ErrInvalidSummaryInterval.Error())
+	}
+
+	resp, err := block.NewGetBlockSummaryUseCase(s.db).Execute(interval, req.GetPeriod())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &indexerpb.GetBlockSummaryResponse{Summaries: ToBlockSummaries(resp)}, nil
+}
+
+func (s *indexerServer) GetValidatorsByHeight(req *indexerpb.GetValidatorsByHeightRequest, stream indexerpb.IndexerService_GetValidatorsByHeightServer) error {
+	resp, err := validator.NewGetByHeightUseCase(s.cfg, s.db, s.client).Execute(toHeight(req.GetHeight()))
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	for _, item := range resp.Items {
+		if err := stream.Send(ToValidatorSeqFromItem(item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *indexerServer) GetValidatorByAddress(_ context.Context, req *indexerpb.GetValidatorByAddressRequest) (*indexerpb.GetValidatorByAddressResponse, error) {
+	if req.GetSequencesLimit() < 0 || req.GetSequencesLimit() > http.MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidSequencesLimit.Error())
+	}
+
+	sequencesPage := store.Pagination{Limit: req.GetSequencesLimit()}
+	if req.GetSequencesCursor() != "" {
+		cursor, err := store.DecodeCursor(req.GetSequencesCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sequencesPage.Cursor = cursor
+	}
+
+	resp, err := validator.NewGetByAddressUseCase(s.db).Execute(req.GetAddress(), sequencesPage)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return ToValidatorByAddressResponse(resp), nil
+}
+
+func (s *indexerServer) GetValidatorSummary(_ context.Context, req *indexerpb.GetValidatorSummaryRequest) (*indexerpb.GetValidatorSummaryResponse, error) {
+	interval := types.SummaryInterval(req.GetInterval())
+	if !interval.Valid() {
+		return nil, status.Error(codes.InvalidArgument, validator.ErrInvalidIntervalPeriod.Error())
+	}
+
+	resp, err := validator.NewGetSummaryUseCase(s.db).Execute(interval, req.GetPeriod(), req.GetAddress())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	switch summaries := resp.(type) {
+	case []store.ValidatorSummaryRow:
+		return &indexerpb.GetValidatorSummaryResponse{Summaries: ToValidatorSummariesFromRows(summaries)}, nil
+	case []model.ValidatorSummary:
+		return &indexerpb.GetValidatorSummaryResponse{Summaries: ToValidatorSummaries(summaries)}, nil
+	default:
+		return nil, toStatusError(errors.Errorf("unexpected validator summary type %T", resp))
+	}
+}
+
+func (s *indexerServer) GetValidatorsForMinHeight(req *indexerpb.GetValidatorsForMinHeightRequest, stream indexerpb.IndexerService_GetValidatorsForMinHeightServer) error {
+	uc := validator.NewGetForMinHeightUseCase(s.db)
+
+	return forEachPage(func(page store.Pagination) (*string, error) {
+		resp, err := uc.Execute(toHeight(req.GetHeight()), page)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.Items {
+			if err := stream.Send(ToValidator(&resp.Items[i])); err != nil {
+				return nil, err
+			}
+		}
+		return resp.NextCursor, nil
+	})
+}
+
+func (s *indexerServer) GetSystemEventsForAddress(req *indexerpb.GetSystemEventsForAddressRequest, stream indexerpb.IndexerService_GetSystemEventsForAddressServer) error {
+	filter := systemevent.FilterRequest{
+		Subscriber:          req.GetSubscriber(),
+		ExcludeAcknowledged: req.GetExcludeAcknowledged(),
+		ExcludeMuted:        req.GetExcludeMuted(),
+	}
+	return s.streamSystemEvents(req.GetAddress(), req.GetAfter(), req.GetKind(), filter, stream)
+}
+
+func (s *indexerServer) GetSystemEventsForNetwork(req *indexerpb.GetSystemEventsForNetworkRequest, stream indexerpb.IndexerService_GetSystemEventsForNetworkServer) error {
+	filter := systemevent.FilterRequest{
+		Subscriber:          req.GetSubscriber(),
+		ExcludeAcknowledged: req.GetExcludeAcknowledged(),
+		ExcludeMuted:        req.GetExcludeMuted(),
+	}
+	return s.streamSystemEvents(model.SystemEventActorNetwork, req.GetAfter(), req.GetKind(), filter, stream)
+}
+
+func (s *indexerServer) GetBalanceForAddress(_ context.Context, req *indexerpb.GetBalanceForAddressRequest) (*indexerpb.GetBalanceForAddressResponse, error) {
+	interval := types.SummaryInterval(req.GetInterval())
+	if interval == "" {
+		interval = types.IntervalDaily
+	}
+	if !interval.Valid() {
+		return nil, status.Error(codes.InvalidArgument, types.ErrInvalidSummaryInterval.Error())
+	}
+
+	start, err := parseDate(req.GetStart())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidDate.Error())
+	}
+	end, err := parseDate(req.GetEnd())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidDate.Error())
+	}
+
+	resp, err := balance.NewGetForAddressUseCase(s.db).Execute(req.GetAddress(), interval, start, end)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &indexerpb.GetBalanceForAddressResponse{Summaries: ToBalanceSummaries(resp)}, nil
+}
+
+// systemEventSender is implemented by both system event streams
+type systemEventSender interface {
+	Send(*indexerpb.SystemEvent) error
+}
+
+func (s *indexerServer) streamSystemEvents(actor string, after int64, kind string, filter systemevent.FilterRequest, stream systemEventSender) error {
+	var systemEventKind *model.SystemEventKind
+	if kind != "" {
+		k := model.SystemEventKind(kind)
+		systemEventKind = &k
+	}
+
+	query, err := filter.ToQuery(toHeight(after), systemEventKind)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	uc := systemevent.NewGetForAddressUseCase(s.db)
+
+	return forEachPage(func(page store.Pagination) (*string, error) {
+		resp, err := uc.Execute(actor, *query, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			if err := stream.Send(ToSystemEvent(item)); err != nil {
+				return nil, err
+			}
+		}
+		return resp.NextCursor, nil
+	})
+}
+
+// forEachPage calls fn with consecutive pages until it returns no next cursor
+func forEachPage(fn func(page store.Pagination) (*string, error)) error {
+	page := store.Pagination{
+		Limit:     http.MaxPageLimit,
+		Direction: store.PageDirectionAsc,
+	}
+
+	for {
+		nextCursor, err := fn(page)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return toStatusError(err)
+		}
+		if nextCursor == nil {
+			return nil
+		}
+
+		cursor, err := store.DecodeCursor(*nextCursor)
+		if err != nil {
+			return toStatusError(err)
+		}
+		page.Cursor = cursor
+	}
+}
+
+// toHeight returns nil for zero height, so use cases default to most recent height
+func toHeight(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+func parseDate(value string) (*types.Time, error) {
+	if value == "" {
+		return types.NewTimeFromTime(time.Time{}), nil
+	}
+
+	t, err := time.Parse(dateFormat, value)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTimeFromTime(t), nil
+}
+
+// toStatusError logs use case error and converts it to gRPC status error
+func toStatusError(err error) error {
+	logger.Error(err)
+
+	switch err {
+	case store.ErrNotFound, store.ErrHeightNotIndexed:
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
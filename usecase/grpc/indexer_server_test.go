@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestForEachPage(t *testing.T) {
+	t.Run("calls fn until there is no next cursor", func(t *testing.T) {
+		cursors := []*string{
+			http.EncodeCursor(&store.Cursor{Height: 10, ID: 1}),
+			http.EncodeCursor(&store.Cursor{Height: 20, ID: 2}),
+			nil,
+		}
+
+		var pages []store.Pagination
+		err := forEachPage(func(page store.Pagination) (*string, error) {
+			pages = append(pages, page)
+			return cursors[len(pages)-1], nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(pages) != 3 {
+			t.Fatalf("unexpected page count, want %v; got %v", 3, len(pages))
+		}
+		if pages[0].Cursor != nil {
+			t.Errorf("unexpected cursor of first page, want nil; got %v", pages[0].Cursor)
+		}
+		if pages[2].Cursor == nil || pages[2].Cursor.Height != 20 {
+			t.Errorf("unexpected cursor of last page, want height %v; got %v", 20, pages[2].Cursor)
+		}
+		for _, page := range pages {
+			if page.Limit != http.MaxPageLimit || page.Direction != store.PageDirectionAsc {
+				t.Errorf("unexpected page, want limit %v asc; got %v %v", http.MaxPageLimit, page.Limit, page.Direction)
+			}
+		}
+	})
+
+	t.Run("returns not found status error", func(t *testing.T) {
+		err := forEachPage(func(page store.Pagination) (*string, error) {
+			return nil, store.ErrNotFound
+		})
+
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("unexpected code, want %v; got %v", codes.NotFound, status.Code(err))
+		}
+	})
+
+	t.Run("returns internal status error", func(t *testing.T) {
+		err := forEachPage(func(page store.Pagination) (*string, error) {
+			return nil, errors.New("test error")
+		})
+
+		if status.Code(err) != codes.Internal {
+			t.Errorf("unexpected code, want %v; got %v", codes.Internal, status.Code(err))
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"os"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+func TestMain(m *testing.M) {
+	setup()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func setup() {
+	logger.InitTest()
+}
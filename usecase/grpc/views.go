@@ -0,0 +1,278 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/grpc/indexer/indexerpb"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/block"
+	"github.com/figment-networks/oasishub-indexer/usecase/chain"
+	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ToStatusResponse(v *chain.DetailsView) *indexerpb.GetStatusResponse {
+	return &indexerpb.GetStatusResponse{
+		AppName:           v.AppName,
+		AppVersion:        v.AppVersion,
+		GoVersion:         v.GoVersion,
+		ChainAppVersion:   v.ChainAppVersion,
+		ChainBlockVersion: v.ChainBlockVersion,
+		ChainId:           v.ChainID,
+		ChainName:         v.ChainName,
+		GenesisHeight:     v.GenesisHeight,
+		GenesisTime:       formatTime(v.GenesisTime),
+		LastIndexVersion:  v.LastIndexVersion,
+		LastIndexedHeight: v.LastIndexedHeight,
+		LastIndexedTime:   formatTime(v.LastIndexedTime),
+		LastIndexedAt:     formatTime(v.LastIndexedAt),
+		IndexingLag:       v.Lag,
+	}
+}
+
+func ToBlock(v *block.DetailsView) *indexerpb.Block {
+	return &indexerpb.Block{
+		AppVersion:         v.AppVersion,
+		BlockVersion:       v.BlockVersion,
+		ChainId:            v.ChainId,
+		Height:             v.Height,
+		Time:               formatTime(v.Time),
+		LastBlockIdHash:    v.LastBlockIdHash,
+		LastCommitHash:     v.LastCommitHash,
+		DataHash:           v.DataHash,
+		ValidatorsHash:     v.ValidatorsHash,
+		NextValidatorsHash: v.NextValidatorsHash,
+		ConsensusHash:      v.ConsensusHash,
+		AppHash:            v.AppHash,
+		LastResultsHash:    v.LastResultsHash,
+		EvidenceHash:       v.EvidenceHash,
+		ProposerAddress:    v.ProposerAddress,
+	}
+}
+
+func ToBlockTimesResponse(r *store.GetAvgRecentTimesResult) *indexerpb.GetBlockTimesResponse {
+	return &indexerpb.GetBlockTimesResponse{
+		StartHeight: r.StartHeight,
+		EndHeight:   r.EndHeight,
+		StartTime:   r.StartTime,
+		EndTime:     r.EndTime,
+		Count:       r.Count,
+		Diff:        r.Diff,
+		Avg:         r.Avg,
+	}
+}
+
+func ToBlockSummaries(ms []model.BlockSummary) []*indexerpb.BlockSummary {
+	summaries := make([]*indexerpb.BlockSummary, len(ms))
+	for i, m := range ms {
+		summaries[i] = &indexerpb.BlockSummary{
+			TimeInterval: string(m.TimeInterval),
+			TimeBucket:   formatTime(m.TimeBucket),
+			Count:        m.Count,
+			BlockTimeAvg: m.BlockTimeAvg,
+		}
+	}
+	return summaries
+}
+
+func ToValidatorSeqFromItem(item validator.SeqListItem) *indexerpb.ValidatorSeq {
+	return &indexerpb.ValidatorSeq{
+		Height:              item.Height,
+		Time:                formatTime(item.Time),
+		Address:             item.Address,
+		EntityUid:           item.EntityUID,
+		EntityName:          item.EntityName,
+		Proposed:            item.Proposed,
+		VotingPower:         item.VotingPower,
+		TotalShares:         item.TotalShares.String(),
+		ActiveEscrowBalance: item.ActiveEscrowBalance.String(),
+		Commission:          item.Commission.String(),
+		Rewards:             item.Rewards.String(),
+		PrecommitValidated:  toBoolValue(item.PrecommitValidated),
+	}
+}
+
+func ToValidatorSeqs(ms []model.ValidatorSeq) []*indexerpb.ValidatorSeq {
+	sequences := make([]*indexerpb.ValidatorSeq, len(ms))
+	for i, m := range ms {
+		sequences[i] = &indexerpb.ValidatorSeq{
+			Height:              m.Height,
+			Time:                formatTime(m.Time),
+			Address:             m.Address,
+			EntityUid:           m.EntityUID,
+			Proposed:            m.Proposed,
+			VotingPower:         m.VotingPower,
+			TotalShares:         m.TotalShares.String(),
+			ActiveEscrowBalance: m.ActiveEscrowBalance.String(),
+			Commission:          m.Commission.String(),
+			Rewards:             m.Rewards.String(),
+			PrecommitValidated:  toBoolValue(m.PrecommitValidated),
+		}
+	}
+	return sequences
+}
+
+func ToValidator(m *model.ValidatorAgg) *indexerpb.Validator {
+	var uptime float64
+	if m.AccumulatedUptimeCount > 0 {
+		uptime = float64(m.AccumulatedUptime) / float64(m.AccumulatedUptimeCount)
+	}
+
+	return &indexerpb.Validator{
+		Address:                   m.Address,
+		EntityUid:                 m.EntityUID,
+		EntityName:                m.EntityName,
+		LogoUrl:                   m.LogoURL,
+		StartedAtHeight:           m.StartedAtHeight,
+		StartedAt:                 formatTime(m.StartedAt),
+		RecentAtHeight:            m.RecentAtHeight,
+		RecentAt:                  formatTime(m.RecentAt),
+		RecentTendermintAddress:   m.RecentTendermintAddress,
+		RecentNodeId:              m.RecentNodeID,
+		RecentVotingPower:         m.RecentVotingPower,
+		RecentTotalShares:         m.RecentTotalShares.String(),
+		RecentActiveEscrowBalance: m.RecentActiveEscrowBalance.String(),
+		RecentCommission:          m.RecentCommission.String(),
+		RecentRewards:             m.RecentRewards.String(),
+		RecentAsValidatorHeight:   m.RecentAsValidatorHeight,
+		RecentProposedHeight:      m.RecentProposedHeight,
+		AccumulatedProposedCount:  m.AccumulatedProposedCount,
+		Uptime:                    uptime,
+	}
+}
+
+func ToValidatorByAddressResponse(v *validator.AggDetailsView) *indexerpb.GetValidatorByAddressResponse {
+	resp := &indexerpb.GetValidatorByAddressResponse{
+		Validator: &indexerpb.Validator{
+			Address:                   v.Address,
+			EntityUid:                 v.EntityUID,
+			EntityName:                v.EntityName,
+			LogoUrl:                   v.LogoURL,
+			RecentTendermintAddress:   v.RecentTendermintAddress,
+			RecentVotingPower:         v.RecentVotingPower,
+			RecentTotalShares:         v.RecentTotalShares.String(),
+			RecentActiveEscrowBalance: v.RecentActiveEscrowBalance.String(),
+			RecentAsValidatorHeight:   v.RecentAsValidatorHeight,
+			RecentProposedHeight:      v.RecentProposedHeight,
+			AccumulatedProposedCount:  v.AccumulatedProposedCount,
+			Uptime:                    v.Uptime,
+		},
+		LastSequences: ToValidatorSeqs(v.LastSequences),
+	}
+
+	if v.Aggregate != nil {
+		resp.Validator.StartedAtHeight = v.StartedAtHeight
+		resp.Validator.StartedAt = formatTime(v.StartedAt)
+		resp.Validator.RecentAtHeight = v.RecentAtHeight
+		resp.Validator.RecentAt = formatTime(v.RecentAt)
+	}
+	if v.NextCursor != nil {
+		resp.NextCursor = *v.NextCursor
+	}
+	return resp
+}
+
+func ToValidatorSummariesFromRows(rows []store.ValidatorSummaryRow) []*indexerpb.ValidatorSummary {
+	summaries := make([]*indexerpb.ValidatorSummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = &indexerpb.ValidatorSummary{
+			TimeInterval:           row.TimeInterval,
+			TimeBucket:             row.TimeBucket,
+			VotingPowerAvg:         row.VotingPowerAvg,
+			VotingPowerMax:         row.VotingPowerMax,
+			VotingPowerMin:         row.VotingPowerMin,
+			TotalSharesAvg:         row.TotalSharesAvg.String(),
+			TotalSharesMax:         row.TotalSharesMax.String(),
+			TotalSharesMin:         row.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: row.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: row.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: row.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          row.CommissionAvg.String(),
+			CommissionMax:          row.CommissionMax.String(),
+			CommissionMin:          row.CommissionMin.String(),
+			ValidatedSum:           row.ValidatedSum,
+			NotValidatedSum:        row.NotValidatedSum,
+			ProposedSum:            row.ProposedSum,
+			UptimeAvg:              row.UptimeAvg,
+		}
+	}
+	return summaries
+}
+
+func ToValidatorSummaries(ms []model.ValidatorSummary) []*indexerpb.ValidatorSummary {
+	summaries := make([]*indexerpb.ValidatorSummary, len(ms))
+	for i, m := range ms {
+		summaries[i] = &indexerpb.ValidatorSummary{
+			Address:                m.Address,
+			TimeInterval:           string(m.TimeInterval),
+			TimeBucket:             formatTime(m.TimeBucket),
+			VotingPowerAvg:         m.VotingPowerAvg,
+			VotingPowerMax:         m.VotingPowerMax,
+			VotingPowerMin:         m.VotingPowerMin,
+			TotalSharesAvg:         m.TotalSharesAvg.String(),
+			TotalSharesMax:         m.TotalSharesMax.String(),
+			TotalSharesMin:         m.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: m.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: m.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: m.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          m.CommissionAvg.String(),
+			CommissionMax:          m.CommissionMax.String(),
+			CommissionMin:          m.CommissionMin.String(),
+			ValidatedSum:           m.ValidatedSum,
+			NotValidatedSum:        m.NotValidatedSum,
+			ProposedSum:            m.ProposedSum,
+			UptimeAvg:              m.UptimeAvg,
+		}
+	}
+	return summaries
+}
+
+func ToSystemEvent(item systemevent.ListItem) *indexerpb.SystemEvent {
+	var id int64
+	if item.Model != nil {
+		id = int64(item.ID)
+	}
+
+	return &indexerpb.SystemEvent{
+		Id:     id,
+		Height: item.Height,
+		Time:   formatTime(item.Time),
+		Actor:  item.Actor,
+		Kind:   item.Kind,
+		Data:   string(item.Data.RawMessage),
+	}
+}
+
+func ToBalanceSummaries(ms []model.BalanceSummary) []*indexerpb.BalanceSummary {
+	summaries := make([]*indexerpb.BalanceSummary, len(ms))
+	for i, m := range ms {
+		summaries[i] = &indexerpb.BalanceSummary{
+			TimeInterval:    string(m.TimeInterval),
+			TimeBucket:      formatTime(m.TimeBucket),
+			StartHeight:     m.StartHeight,
+			Address:         m.Address,
+			EscrowAddress:   m.EscrowAddress,
+			TotalRewards:    m.TotalRewards.String(),
+			TotalCommission: m.TotalCommission.String(),
+			TotalSlashed:    m.TotalSlashed.String(),
+		}
+	}
+	return summaries
+}
+
+func toBoolValue(value *bool) *wrapperspb.BoolValue {
+	if value == nil {
+		return nil
+	}
+	return wrapperspb.Bool(*value)
+}
+
+func formatTime(t types.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
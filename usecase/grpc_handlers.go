@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/grpc/indexer/indexerpb"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/apikey"
+	"github.com/figment-networks/oasishub-indexer/usecase/grpc"
+)
+
+func NewGrpcHandlers(cfg *config.Config, db *store.Store, c *client.Client) *GrpcHandlers {
+	return &GrpcHandlers{
+		Indexer: grpc.NewIndexerServer(cfg, db, c),
+
+		Auth: apikey.NewAuthGrpcInterceptor(cfg, db),
+	}
+}
+
+type GrpcHandlers struct {
+	Indexer indexerpb.IndexerServiceServer
+
+	Auth *apikey.AuthGrpcInterceptor
+}
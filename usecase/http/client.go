@@ -0,0 +1,47 @@
+package http
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	apiKeyKey = "api_key"
+)
+
+var (
+	ErrSubscriberRequired   = errors.New("subscriber is required")
+	ErrSubscriberNotAllowed = errors.New("subscriber must be name of api key")
+)
+
+// SetAPIKey marks request as authenticated with api key
+func SetAPIKey(c *gin.Context, apiKey *model.APIKey) {
+	c.Set(apiKeyKey, apiKey)
+}
+
+// APIKey returns api key request was authenticated with or nil for anonymous requests
+func APIKey(c *gin.Context) *model.APIKey {
+	if value, ok := c.Get(apiKeyKey); ok {
+		return value.(*model.APIKey)
+	}
+	return nil
+}
+
+// Subscriber returns subscriber acknowledgements and mutes are recorded for.
+// Requests authenticated with api key act as subscriber named after the key,
+// anonymous requests have to provide subscriber, which is taken as is
+func Subscriber(c *gin.Context, requested string) (string, error) {
+	apiKey := APIKey(c)
+	if apiKey == nil {
+		if requested == "" {
+			return "", ErrSubscriberRequired
+		}
+		return requested, nil
+	}
+
+	if requested != "" && requested != apiKey.Name {
+		return "", ErrSubscriberNotAllowed
+	}
+	return apiKey.Name, nil
+}
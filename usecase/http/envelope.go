@@ -0,0 +1,71 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	APIVersion1 = "v1"
+
+	apiVersionKey = "api_version"
+)
+
+// Envelope wraps every response of versioned API
+type Envelope struct {
+	Data       interface{}     `json:"data"`
+	Meta       Meta            `json:"meta"`
+	Pagination *PaginationMeta `json:"pagination"`
+	Error      *ErrorBody      `json:"error,omitempty"`
+}
+
+type Meta struct {
+	APIVersion string `json:"api_version"`
+}
+
+type PaginationMeta struct {
+	NextCursor *string `json:"next_cursor"`
+}
+
+type ErrorBody struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// PaginatedView is implemented by list views paginated with cursor.
+// Versioned API renders page items as data and cursor as pagination
+type PaginatedView interface {
+	PageItems() interface{}
+	PageNextCursor() *string
+}
+
+// NewEnvelope wraps response data
+func NewEnvelope(version string, data interface{}) *Envelope {
+	envelope := &Envelope{
+		Data: data,
+		Meta: Meta{APIVersion: version},
+	}
+
+	if view, ok := data.(PaginatedView); ok {
+		envelope.Data = view.PageItems()
+		envelope.Pagination = &PaginationMeta{NextCursor: view.PageNextCursor()}
+	}
+	return envelope
+}
+
+// NewErrorEnvelope wraps error with its code
+func NewErrorEnvelope(version string, code ErrorCode, err error) *Envelope {
+	return &Envelope{
+		Meta:  Meta{APIVersion: version},
+		Error: &ErrorBody{Code: code, Message: err.Error()},
+	}
+}
+
+// SetAPIVersion marks request as routed to versioned API
+func SetAPIVersion(c *gin.Context, version string) {
+	c.Set(apiVersionKey, version)
+}
+
+// APIVersion returns version of API request was routed to or empty string for unversioned routes
+func APIVersion(c *gin.Context) string {
+	return c.GetString(apiVersionKey)
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type testListView struct {
+	Items      []string `json:"items"`
+	NextCursor *string  `json:"next_cursor"`
+}
+
+func (v testListView) PageItems() interface{} {
+	return v.Items
+}
+
+func (v testListView) PageNextCursor() *string {
+	return v.NextCursor
+}
+
+func TestNewEnvelope(t *testing.T) {
+	t.Run("details view", func(t *testing.T) {
+		envelope := NewEnvelope(APIVersion1, map[string]int{"height": 10})
+
+		if envelope.Meta.APIVersion != APIVersion1 {
+			t.Errorf("unexpected api version: %v", envelope.Meta.APIVersion)
+		}
+		if envelope.Pagination != nil {
+			t.Errorf("unexpected pagination: %v", envelope.Pagination)
+		}
+		if _, ok := envelope.Data.(map[string]int); !ok {
+			t.Errorf("unexpected data: %v", envelope.Data)
+		}
+	})
+
+	t.Run("paginated view", func(t *testing.T) {
+		cursor := "abc"
+		envelope := NewEnvelope(APIVersion1, &testListView{Items: []string{"a", "b"}, NextCursor: &cursor})
+
+		items, ok := envelope.Data.([]string)
+		if !ok || len(items) != 2 {
+			t.Errorf("unexpected data: %v", envelope.Data)
+		}
+		if envelope.Pagination == nil || envelope.Pagination.NextCursor != &cursor {
+			t.Errorf("unexpected pagination: %v", envelope.Pagination)
+		}
+	})
+}
+
+func TestToErrorCode(t *testing.T) {
+	tests := []struct {
+		status int
+		err    error
+		code   ErrorCode
+	}{
+		{http.StatusNotFound, store.ErrNotFound, ErrorCodeNotFound},
+		{http.StatusNotFound, store.ErrHeightNotIndexed, ErrorCodeHeightNotIndexed},
+		{http.StatusBadRequest, store.ErrInvalidCursor, ErrorCodeInvalidCursor},
+		{http.StatusBadRequest, ErrInvalidPageLimit, ErrorCodeInvalidPagination},
+		{http.StatusBadRequest, errors.New("invalid height"), ErrorCodeInvalidRequest},
+		{http.StatusInternalServerError, errors.New("boom"), ErrorCodeInternal},
+	}
+
+	for _, tt := range tests {
+		if code := ToErrorCode(tt.status, tt.err); code != tt.code {
+			t.Errorf("unexpected code for %v, want %v; got %v", tt.err, tt.code, code)
+		}
+	}
+}
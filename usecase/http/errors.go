@@ -0,0 +1,57 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+// ErrorCode is a stable machine readable code of versioned API error
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidRequest    ErrorCode = "INVALID_REQUEST"
+	ErrorCodeInvalidPagination ErrorCode = "INVALID_PAGINATION"
+	ErrorCodeInvalidCursor     ErrorCode = "INVALID_CURSOR"
+	ErrorCodeInvalidInterval   ErrorCode = "INVALID_INTERVAL"
+	ErrorCodeUnauthorized      ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden         ErrorCode = "FORBIDDEN"
+	ErrorCodeRateLimited       ErrorCode = "RATE_LIMITED"
+	ErrorCodeNotFound          ErrorCode = "NOT_FOUND"
+	ErrorCodeHeightNotIndexed  ErrorCode = "HEIGHT_NOT_INDEXED"
+	ErrorCodeInternal          ErrorCode = "INTERNAL_ERROR"
+)
+
+var (
+	errorCodes = map[error]ErrorCode{
+		ErrInvalidPageLimit:             ErrorCodeInvalidPagination,
+		store.ErrInvalidPageDirection:   ErrorCodeInvalidPagination,
+		store.ErrInvalidCursor:          ErrorCodeInvalidCursor,
+		types.ErrInvalidSummaryInterval: ErrorCodeInvalidInterval,
+		store.ErrNotFound:               ErrorCodeNotFound,
+		store.ErrHeightNotIndexed:       ErrorCodeHeightNotIndexed,
+	}
+)
+
+// ToErrorCode returns code of known error or generic code of response status
+func ToErrorCode(status int, err error) ErrorCode {
+	if code, ok := errorCodes[err]; ok {
+		return code
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeInvalidRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	default:
+		return ErrorCodeInternal
+	}
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+var (
+	ErrInvalidPageLimit = errors.New("invalid limit")
+)
+
+// PaginationRequest holds pagination query parameters shared by list endpoints
+type PaginationRequest struct {
+	Limit     int64  `form:"limit" binding:"-"`
+	Cursor    string `form:"cursor" binding:"-"`
+	Direction string `form:"direction" binding:"-"`
+}
+
+// ToPagination validates request and converts it to store pagination
+func (r PaginationRequest) ToPagination() (*store.Pagination, error) {
+	p := &store.Pagination{
+		Limit:     r.Limit,
+		Direction: store.PageDirection(r.Direction),
+	}
+
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxPageLimit {
+		return nil, ErrInvalidPageLimit
+	}
+
+	if p.Direction == "" {
+		p.Direction = store.PageDirectionDesc
+	}
+	if !p.Direction.Valid() {
+		return nil, store.ErrInvalidPageDirection
+	}
+
+	if r.Cursor != "" {
+		cursor, err := store.DecodeCursor(r.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		p.Cursor = cursor
+	}
+
+	return p, nil
+}
+
+// EncodeCursor returns encoded cursor or nil when there is no next page
+func EncodeCursor(c *store.Cursor) *string {
+	if c == nil {
+		return nil
+	}
+	encoded := c.Encode()
+	return &encoded
+}
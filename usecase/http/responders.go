@@ -13,6 +13,21 @@ func BadRequest(c *gin.Context, err error) {
 	jsonError(c, http.StatusBadRequest, err)
 }
 
+// Unauthorized renders a HTTP 401 unauthorized response
+func Unauthorized(c *gin.Context, err error) {
+	jsonError(c, http.StatusUnauthorized, err)
+}
+
+// Forbidden renders a HTTP 403 forbidden response
+func Forbidden(c *gin.Context, err error) {
+	jsonError(c, http.StatusForbidden, err)
+}
+
+// TooManyRequests renders a HTTP 429 too many requests response
+func TooManyRequests(c *gin.Context, err error) {
+	jsonError(c, http.StatusTooManyRequests, err)
+}
+
 // NotFound renders a HTTP 404 not found response
 func NotFound(c *gin.Context, err error) {
 	jsonError(c, http.StatusNotFound, err)
@@ -25,11 +40,21 @@ func ServerError(c *gin.Context, err error) {
 
 // JsonOK renders a successful response
 func JsonOK(c *gin.Context, data interface{}) {
+	if version := APIVersion(c); version != "" {
+		c.JSON(http.StatusOK, NewEnvelope(version, data))
+		return
+	}
+
 	c.JSON(http.StatusOK, data)
 }
 
 // jsonError renders an error response
 func jsonError(c *gin.Context, status int, err error) {
+	if version := APIVersion(c); version != "" {
+		c.AbortWithStatusJSON(status, NewErrorEnvelope(version, ToErrorCode(status, err), err))
+		return
+	}
+
 	c.AbortWithStatusJSON(status, gin.H{
 		"status": status,
 		"error":  err.Error(),
@@ -45,9 +70,13 @@ func ShouldReturn(c *gin.Context, err error) bool {
 	// log error
 	logger.Error(err)
 
-	if err == store.ErrNotFound {
+	switch {
+	case err == store.ErrNotFound:
+		NotFound(c, err)
+	case err == store.ErrHeightNotIndexed && APIVersion(c) != "":
+		// Unversioned routes keep responding with server error
 		NotFound(c, err)
-	} else {
+	default:
 		ServerError(c, err)
 	}
 
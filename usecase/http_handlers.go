@@ -6,16 +6,22 @@ import (
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/figment-networks/oasishub-indexer/usecase/account"
+	"github.com/figment-networks/oasishub-indexer/usecase/apikey"
 	"github.com/figment-networks/oasishub-indexer/usecase/balance"
 	"github.com/figment-networks/oasishub-indexer/usecase/block"
 	"github.com/figment-networks/oasishub-indexer/usecase/chain"
 	"github.com/figment-networks/oasishub-indexer/usecase/debondingdelegation"
 	"github.com/figment-networks/oasishub-indexer/usecase/delegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/graphql"
 	"github.com/figment-networks/oasishub-indexer/usecase/health"
+	"github.com/figment-networks/oasishub-indexer/usecase/openapi"
+	"github.com/figment-networks/oasishub-indexer/usecase/portfolio"
 	"github.com/figment-networks/oasishub-indexer/usecase/staking"
+	"github.com/figment-networks/oasishub-indexer/usecase/stream"
 	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
 	"github.com/figment-networks/oasishub-indexer/usecase/transaction"
 	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
 )
 
 func NewHttpHandlers(cfg *config.Config, db *store.Store, c *client.Client) *HttpHandlers {
@@ -37,8 +43,24 @@ func NewHttpHandlers(cfg *config.Config, db *store.Store, c *client.Client) *Htt
 		GetValidatorByAddress:            validator.NewGetByAddressHttpHandler(db, c),
 		GetValidatorSummary:              validator.NewGetSummaryHttpHandler(db, c),
 		GetValidatorsForMinHeight:        validator.NewGetForMinHeightHttpHandler(db, c),
+		GetValidatorLeaderboard:          validator.NewGetLeaderboardHttpHandler(db, c),
+		GetValidatorReturns:              validator.NewGetReturnsHttpHandler(db, c),
+		GetValidatorSharePrice:           validator.NewGetSharePriceHttpHandler(db, c),
 		GetSystemEventsForAddress:        systemevent.NewGetForAddressHttpHandler(db, c),
+		GetSystemEventsForNetwork:        systemevent.NewGetForNetworkHttpHandler(db, c),
+		AcknowledgeSystemEvent:           systemevent.NewAcknowledgeHttpHandler(db),
+		MuteValidator:                    validator.NewMuteHttpHandler(db),
 		GetBalanceForAddress:             balance.NewGetForAddressHttpHandler(db, c),
+		GetPortfolioByAddress:            portfolio.NewGetByAddressHttpHandler(cfg, db, c),
+		GetWebhookSubscriptions:          webhook.NewGetSubscriptionsHttpHandler(db),
+		CreateWebhookSubscription:        webhook.NewCreateSubscriptionHttpHandler(cfg, db),
+		DeleteWebhookSubscription:        webhook.NewDeleteSubscriptionHttpHandler(db),
+		GetWebhookDeliveries:             webhook.NewGetDeliveriesHttpHandler(db),
+		Stream:                           stream.NewStreamHttpHandler(cfg, db),
+		ExecuteGraphQL:                   graphql.NewExecuteHttpHandler(db),
+		GetOpenAPISpec:                   openapi.NewGetSpecHttpHandler(),
+		GetSwaggerUI:                     openapi.NewSwaggerUIHttpHandler(),
+		Auth:                             apikey.NewAuthHttpHandler(cfg, db),
 	}
 }
 
@@ -59,7 +81,24 @@ type HttpHandlers struct {
 	GetValidatorByAddress            types.HttpHandler
 	GetValidatorSummary              types.HttpHandler
 	GetValidatorsForMinHeight        types.HttpHandler
+	GetValidatorLeaderboard          types.HttpHandler
+	GetValidatorReturns              types.HttpHandler
+	GetValidatorSharePrice           types.HttpHandler
 	GetSystemEventsForAddress        types.HttpHandler
+	GetSystemEventsForNetwork        types.HttpHandler
+	AcknowledgeSystemEvent           types.HttpHandler
+	MuteValidator                    types.HttpHandler
 	GetBalanceForAddress             types.HttpHandler
+	GetPortfolioByAddress            types.HttpHandler
 	GetDelegationsByAddress          types.HttpHandler
+	GetWebhookSubscriptions          types.HttpHandler
+	CreateWebhookSubscription        types.HttpHandler
+	DeleteWebhookSubscription        types.HttpHandler
+	GetWebhookDeliveries             types.HttpHandler
+	Stream                           types.HttpHandler
+	ExecuteGraphQL                   types.HttpHandler
+	GetOpenAPISpec                   types.HttpHandler
+	GetSwaggerUI                     types.HttpHandler
+
+	Auth *apikey.AuthHttpHandler
 }
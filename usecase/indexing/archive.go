@@ -0,0 +1,124 @@
+package indexing
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	archiveDayLayout     = "2006-01-02"
+	archiveFileExtension = ".ndjson.gz"
+	archiveMaxLineSize   = 16 * 1024 * 1024
+)
+
+// archive stores records as gzip compressed NDJSON files partitioned by table and day
+type archive struct {
+	dir string
+}
+
+func newArchive(dir string) *archive {
+	return &archive{
+		dir: dir,
+	}
+}
+
+func (a *archive) path(table string, day time.Time) string {
+	return filepath.Join(a.dir, table, day.UTC().Format(archiveDayLayout)+archiveFileExtension)
+}
+
+// NewWriter returns writer appending records of table to the archive
+func (a *archive) NewWriter(table string) *archiveWriter {
+	return &archiveWriter{
+		archive: a,
+		table:   table,
+		files:   map[string]*archiveFile{},
+	}
+}
+
+// Read calls fn with every record of table archived for given day.
+// It returns os.ErrNotExist when there is nothing archived for the day
+func (a *archive) Read(table string, day time.Time, fn func([]byte) error) error {
+	f, err := os.Open(a.path(table, day))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// gzip.Reader reads concatenated streams, so files appended by multiple purges are read as one
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 64*1024), archiveMaxLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := fn(scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+type archiveFile struct {
+	file *os.File
+	gz   *gzip.Writer
+}
+
+// archiveWriter keeps files of the table open until it is closed
+type archiveWriter struct {
+	archive *archive
+	table   string
+	files   map[string]*archiveFile
+}
+
+// Write appends record to the file of given day
+func (w *archiveWriter) Write(day time.Time, data []byte) error {
+	path := w.archive.path(w.table, day)
+
+	f, ok := w.files[path]
+	if !ok {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+
+		f = &archiveFile{file: file, gz: gzip.NewWriter(file)}
+		w.files[path] = f
+	}
+
+	if _, err := f.gz.Write(data); err != nil {
+		return err
+	}
+	_, err := f.gz.Write([]byte("\n"))
+	return err
+}
+
+// Close flushes and syncs all files. Records must not be deleted before Close succeeds
+func (w *archiveWriter) Close() error {
+	var closeErr error
+	for path, f := range w.files {
+		if err := f.gz.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		if err := f.file.Sync(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		if err := f.file.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		delete(w.files, path)
+	}
+	return closeErr
+}
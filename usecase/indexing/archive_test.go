@@ -0,0 +1,56 @@
+package indexing
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestArchive_WriteRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	a := newArchive(dir)
+	day := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	// two purge runs append to the same file
+	for _, records := range [][]string{{`{"id":1}`, `{"id":2}`}, {`{"id":3}`}} {
+		w := a.NewWriter("block_sequences")
+		for _, r := range records {
+			if err := w.Write(day, []byte(r)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var got []string
+	err = a.Read("block_sequences", day, func(data []byte) error {
+		got = append(got, string(data))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{`{"id":1}`, `{"id":2}`, `{"id":3}`}
+	if len(got) != len(expected) {
+		t.Fatalf("unexpected records count; got: %d, want: %d", len(got), len(expected))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("unexpected record; got: %s, want: %s", got[i], expected[i])
+		}
+	}
+
+	err = a.Read("block_sequences", day.AddDate(0, 0, 1), func([]byte) error { return nil })
+	if !os.IsNotExist(err) {
+		t.Errorf("expected not exist error; got: %v", err)
+	}
+}
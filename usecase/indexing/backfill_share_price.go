@@ -0,0 +1,84 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type backfillSharePriceUseCase struct {
+	db *store.Store
+}
+
+func NewBackfillSharePriceUseCase(db *store.Store) *backfillSharePriceUseCase {
+	return &backfillSharePriceUseCase{
+		db: db,
+	}
+}
+
+// Execute sets share price of validator summaries created before share price was summarized.
+// Summaries are updated in batches of whole buckets, so every batch runs in its own short transaction.
+// Summaries which sequences were already purged keep share price of 0
+func (uc *backfillSharePriceUseCase) Execute(ctx context.Context) error {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("backfill_share_price"))
+	defer t.ObserveDuration()
+
+	first, err := uc.db.ValidatorSeq.FindFirst()
+	if err != nil {
+		if err == store.ErrNotFound {
+			logger.Info("no validator sequences to backfill share price from")
+			return nil
+		}
+		return err
+	}
+
+	last, err := uc.db.ValidatorSeq.FindMostRecent()
+	if err != nil {
+		return err
+	}
+
+	for _, interval := range []types.SummaryInterval{types.IntervalHourly, types.IntervalDaily, types.IntervalWeekly, types.IntervalMonthly} {
+		count, err := uc.backfillInterval(ctx, interval, first.Time.Time, last.Time.Time)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("share price backfilled [interval=%s] [count=%d]", interval, count))
+	}
+
+	return nil
+}
+
+// backfillInterval updates summaries of interval between from and to in batches.
+// Hourly summaries are updated a day at a time, other intervals a bucket at a time
+func (uc *backfillSharePriceUseCase) backfillInterval(ctx context.Context, interval types.SummaryInterval, from time.Time, to time.Time) (int64, error) {
+	batchInterval := interval
+	if interval == types.IntervalHourly {
+		batchInterval = types.IntervalDaily
+	}
+
+	var totalCount int64
+	for start := batchInterval.Truncate(from); !start.After(to); {
+		if err := ctx.Err(); err != nil {
+			return totalCount, err
+		}
+
+		duration, err := batchInterval.ToDuration(start)
+		if err != nil {
+			return totalCount, err
+		}
+		end := start.Add(duration)
+
+		count, err := uc.db.ValidatorSummary.BackfillSharePrice(interval, start, end)
+		if err != nil {
+			return totalCount, err
+		}
+		totalCount += count
+		start = end
+	}
+	return totalCount, nil
+}
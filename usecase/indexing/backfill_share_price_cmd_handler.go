@@ -0,0 +1,44 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type BackfillSharePriceCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *backfillSharePriceUseCase
+}
+
+func NewBackfillSharePriceCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *BackfillSharePriceCmdHandler {
+	return &BackfillSharePriceCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *BackfillSharePriceCmdHandler) Handle(ctx context.Context) {
+	logger.Info(fmt.Sprintf("running backfill share price use case [handler=cmd]"))
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *BackfillSharePriceCmdHandler) getUseCase() *backfillSharePriceUseCase {
+	if h.useCase == nil {
+		h.useCase = NewBackfillSharePriceUseCase(h.db)
+	}
+	return h.useCase
+}
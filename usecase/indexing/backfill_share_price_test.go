@@ -0,0 +1,67 @@
+package indexing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestBackfillSharePriceUseCase_Execute(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2020, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("updates summaries in batches aligned to buckets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		validatorSeqStore := mock_store.NewMockValidatorSeqStore(ctrl)
+		validatorSummaryStore := mock_store.NewMockValidatorSummaryStore(ctrl)
+
+		// Friday to Saturday of the next month
+		validatorSeqStore.EXPECT().FindFirst().Return(&model.ValidatorSeq{Sequence: &model.Sequence{Time: *types.NewTimeFromTime(time.Date(2020, 7, 31, 10, 0, 0, 0, time.UTC))}}, nil).Times(1)
+		validatorSeqStore.EXPECT().FindMostRecent().Return(&model.ValidatorSeq{Sequence: &model.Sequence{Time: *types.NewTimeFromTime(time.Date(2020, 8, 1, 2, 0, 0, 0, time.UTC))}}, nil).Times(1)
+
+		gomock.InOrder(
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalHourly, day(7, 31), day(8, 1)).Return(int64(14), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalHourly, day(8, 1), day(8, 2)).Return(int64(3), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalDaily, day(7, 31), day(8, 1)).Return(int64(1), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalDaily, day(8, 1), day(8, 2)).Return(int64(1), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalWeekly, day(7, 27), day(8, 3)).Return(int64(1), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalMonthly, day(7, 1), day(8, 1)).Return(int64(1), nil),
+			validatorSummaryStore.EXPECT().BackfillSharePrice(types.IntervalMonthly, day(8, 1), day(9, 1)).Return(int64(1), nil),
+		)
+
+		uc := NewBackfillSharePriceUseCase(&store.Store{
+			ValidatorSeq:     validatorSeqStore,
+			ValidatorSummary: validatorSummaryStore,
+		})
+
+		if err := uc.Execute(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does nothing without validator sequences", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		validatorSeqStore := mock_store.NewMockValidatorSeqStore(ctrl)
+		validatorSeqStore.EXPECT().FindFirst().Return(nil, store.ErrNotFound).Times(1)
+
+		uc := NewBackfillSharePriceUseCase(&store.Store{
+			ValidatorSeq:     validatorSeqStore,
+			ValidatorSummary: mock_store.NewMockValidatorSummaryStore(ctrl),
+		})
+
+		if err := uc.Execute(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
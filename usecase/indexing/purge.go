@@ -2,12 +2,14 @@ package indexing
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/indexer"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
@@ -16,6 +18,16 @@ import (
 
 var (
 	ErrPurgingDisabled = errors.New("purging disabled")
+
+	// summarySources maps purged tables to source tables which summary watermarks tell how far their summaries are complete
+	summarySources = map[string]string{
+		model.BlockSeq{}.TableName():         model.BlockSeq{}.TableName(),
+		model.BlockSummary{}.TableName():     model.BlockSeq{}.TableName(),
+		model.ValidatorSeq{}.TableName():     model.ValidatorSeq{}.TableName(),
+		model.ValidatorSummary{}.TableName(): model.ValidatorSeq{}.TableName(),
+		model.BalanceEvent{}.TableName():     model.BalanceEvent{}.TableName(),
+		model.BalanceSummary{}.TableName():   model.BalanceEvent{}.TableName(),
+	}
 )
 
 type purgeUseCase struct {
@@ -30,9 +42,28 @@ func NewPurgeUseCase(cfg *config.Config, db *store.Store) *purgeUseCase {
 	}
 }
 
+// purgeReportItem holds outcome of purging single table
+type purgeReportItem struct {
+	Table         string                `json:"table"`
+	TimeInterval  types.SummaryInterval `json:"time_interval,omitempty"`
+	Threshold     *time.Time            `json:"threshold,omitempty"`
+	DeletedCount  int64                 `json:"deleted_count"`
+	ArchivedCount int64                 `json:"archived_count,omitempty"`
+	SkippedCount  int64                 `json:"skipped_count"`
+	Skipped       []purgeSkippedBucket  `json:"skipped,omitempty"`
+	Reason        string                `json:"reason,omitempty"`
+}
+
+// purgeSkippedBucket holds records which were not purged
+type purgeSkippedBucket struct {
+	TimeBucket types.Time `json:"time_bucket"`
+	Count      int64      `json:"count"`
+	Reason     string     `json:"reason"`
+}
+
 func (uc *purgeUseCase) Execute(ctx context.Context) error {
 	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("purge"))
-	t.ObserveDuration()
+	defer t.ObserveDuration()
 
 	targetsReader, err := indexer.NewConfigParser(uc.cfg.IndexerConfigFile)
 	if err != nil {
@@ -40,230 +71,191 @@ func (uc *purgeUseCase) Execute(ctx context.Context) error {
 	}
 	currentIndexVersion := targetsReader.GetCurrentVersionId()
 
-	if err := uc.purgeBlocks(currentIndexVersion); err != nil {
+	policies, err := uc.cfg.GetRetentionPolicies()
+	if err != nil {
 		return err
 	}
 
-	if err := uc.purgeValidators(currentIndexVersion); err != nil {
-		return err
+	report := &model.Report{
+		Kind:         model.ReportKindPurge,
+		IndexVersion: currentIndexVersion,
 	}
-
-	if err := uc.purgeSystemEvents(); err != nil {
+	if err := uc.db.Reports.Create(report); err != nil {
 		return err
 	}
 
-	return uc.purgeBalanceEvents()
-}
-
-func (uc *purgeUseCase) purgeBalanceEvents() error {
-	lastEventTime, err := uc.db.BalanceEvents.GetLastEventTime()
-	if err != nil {
-		return err
-	}
-
-	duration, err := uc.parseDuration(uc.cfg.PurgeBalanceEventsInterval)
-	if err != nil {
-		if err == ErrPurgingDisabled {
-			logger.Info("purging balance events disabled. Purge interval set to 0.")
+	var items []purgeReportItem
+	var deletedCount, skippedCount int64
+	var purgeErr error
+	for _, policy := range policies {
+		item, err := uc.purge(policy, currentIndexVersion)
+		if err != nil {
+			purgeErr = err
+			break
 		}
-		return err
+		items = append(items, *item)
+		deletedCount += item.DeletedCount
+		skippedCount += item.SkippedCount
 	}
 
-	purgeThresholdFromNow := lastEventTime.Add(-*duration)
-
-	logger.Info(fmt.Sprintf("purging balance events... [older than=%s]", purgeThresholdFromNow))
-
-	deletedCount, err := uc.db.BalanceEvents.DeleteOlderThan(purgeThresholdFromNow)
+	details, err := json.Marshal(items)
 	if err != nil {
 		return err
 	}
+	report.Details = &types.Jsonb{RawMessage: details}
+	report.Complete(deletedCount, 0, purgeErr)
+	report.SkippedCount = &skippedCount
 
-	logger.Info(fmt.Sprintf("%d balance events purged", *deletedCount))
-
-	return nil
-}
-
-func (uc *purgeUseCase) purgeBlocks(currentIndexVersion int64) error {
-	if err := uc.purgeBlockSequences(currentIndexVersion); uc.checkErr(err) {
-		return err
-	}
-	if err := uc.purgeBlockSummaries(types.IntervalHourly, uc.cfg.PurgeHourlySummariesInterval); uc.checkErr(err) {
+	if err := uc.db.Reports.Save(report); err != nil {
 		return err
 	}
-	return nil
-}
 
-func (uc *purgeUseCase) purgeValidators(currentIndexVersion int64) error {
-	if err := uc.purgeValidatorSequences(currentIndexVersion); uc.checkErr(err) {
-		return err
-	}
-	if err := uc.purgeValidatorSummaries(types.IntervalHourly, uc.cfg.PurgeHourlySummariesInterval); uc.checkErr(err) {
-		return err
-	}
-	return nil
+	logger.Info(fmt.Sprintf("purge completed [deleted=%d] [skipped=%d]", deletedCount, skippedCount))
+
+	return purgeErr
 }
 
-func (uc *purgeUseCase) purgeSystemEvents() error {
-	systemEvent, err := uc.db.SystemEvents.FindMostRecent()
-	if err != nil {
-		return err
+// purge deletes records of the table according to retention policy.
+// Records which time bucket has no summary with required coverage are skipped
+func (uc *purgeUseCase) purge(policy config.RetentionPolicy, currentIndexVersion int64) (*purgeReportItem, error) {
+	item := &purgeReportItem{
+		Table:        policy.Table,
+		TimeInterval: policy.TimeInterval,
 	}
-	lastRecordTime := systemEvent.Time.Time
 
-	duration, err := uc.parseDuration(uc.cfg.PurgeSystemEventsInterval)
+	duration, err := uc.parseDuration(policy.Interval)
 	if err != nil {
 		if err == ErrPurgingDisabled {
-			logger.Info("purging system events disabled. Purge interval set to 0.")
+			logger.Info(fmt.Sprintf("purging disabled [table=%s] [time_interval=%s]", policy.Table, policy.TimeInterval))
+			item.Reason = err.Error()
+			return item, nil
 		}
-		return err
+		return nil, err
 	}
 
-	purgeThresholdFromLastRecord := lastRecordTime.Add(- *duration)
-
-	logger.Info(fmt.Sprintf("purging system events... [older than=%s]", purgeThresholdFromLastRecord))
-
-	deletedCount, err := uc.db.SystemEvents.DeleteOlderThan(purgeThresholdFromLastRecord)
+	lastRecordTime, err := uc.db.Retention.FindMostRecentTime(policy.Table, policy.TimeInterval)
 	if err != nil {
-		return err
+		if err == store.ErrNotFound {
+			item.Reason = "no records"
+			return item, nil
+		}
+		return nil, err
 	}
 
-	logger.Info(fmt.Sprintf("%d system events purged", *deletedCount))
+	threshold := lastRecordTime.Add(-*duration)
+	if policy.MinSummaryCoverage != "" {
+		// Summaries of incomplete buckets are recalculated, so only records from complete buckets can be purged
+		threshold = policy.MinSummaryCoverage.Truncate(threshold)
 
-	return nil
-}
-
-func (uc *purgeUseCase) purgeBlockSequences(currentIndexVersion int64) error {
-	blockSeq, err := uc.db.BlockSeq.FindMostRecent()
-	if err != nil {
-		return err
-	}
-	lastSeqTime := blockSeq.Time.Time
-
-	duration, err := uc.parseDuration(uc.cfg.PurgeSequencesInterval)
-	if err != nil {
-		if err == ErrPurgingDisabled {
-			logger.Info("purging block sequences disabled. Purge interval set to 0.")
+		// Summary of bucket exists as soon as part of it is summarized, so only buckets which end before summary watermark are covered
+		summarizedUntil, err := uc.getSummarizedUntil(policy.Table, currentIndexVersion)
+		if err != nil {
+			if err == store.ErrNotFound {
+				item.Reason = "no summaries"
+				return item, nil
+			}
+			return nil, err
 		}
-		return err
-	}
 
-	purgeThresholdFromLastSeq := lastSeqTime.Add(-*duration)
-
-	activityPeriods, err := uc.db.BlockSummary.FindActivityPeriods(types.IntervalDaily, currentIndexVersion)
-	if err != nil {
-		return err
+		// Bucket containing watermark is incomplete, all buckets before it end at or before watermark
+		coveredUntil := policy.MinSummaryCoverage.Truncate(*summarizedUntil)
+		if coveredUntil.Before(threshold) {
+			logger.Info(fmt.Sprintf("summaries are behind [table=%s] [summarized until=%s]", policy.Table, summarizedUntil))
+			threshold = coveredUntil
+		}
 	}
+	item.Threshold = &threshold
 
-	logger.Info(fmt.Sprintf("purging summarized block sequences... [older than=%s]", purgeThresholdFromLastSeq))
-
-	deletedCount, err := uc.db.BlockSeq.DeleteOlderThan(purgeThresholdFromLastSeq, activityPeriods)
-	if err != nil {
-		return err
+	query := store.RetentionQuery{
+		Table:        policy.Table,
+		TimeInterval: policy.TimeInterval,
+		Threshold:    threshold,
+		Coverage:     policy.MinSummaryCoverage,
+		IndexVersion: currentIndexVersion,
 	}
 
-	logger.Info(fmt.Sprintf("%d block sequences purged", *deletedCount))
+	logger.Info(fmt.Sprintf("purging... [table=%s] [time_interval=%s] [older than=%s]", policy.Table, policy.TimeInterval, threshold))
 
-	return nil
-}
-
-func (uc *purgeUseCase) purgeBlockSummaries(interval types.SummaryInterval, purgeInterval string) error {
-	blockSummary, err := uc.db.BlockSummary.FindMostRecentByInterval(interval)
-	if err != nil {
-		return err
-	}
-	lastSummaryTimeBucket := blockSummary.TimeBucket.Time
+	if policy.MinSummaryCoverage != "" {
+		uncoveredBuckets, err := uc.db.Retention.FindUncovered(query)
+		if err != nil {
+			return nil, err
+		}
 
-	duration, err := uc.parseDuration(purgeInterval)
-	if err != nil {
-		if err == ErrPurgingDisabled {
-			logger.Info(fmt.Sprintf("purging block summaries disabled [interval=%s] [purge_interval=%s]", interval, purgeInterval))
+		for _, bucket := range uncoveredBuckets {
+			item.Skipped = append(item.Skipped, purgeSkippedBucket{
+				TimeBucket: bucket.TimeBucket,
+				Count:      bucket.Count,
+				Reason:     fmt.Sprintf("no %s summary for time bucket", policy.MinSummaryCoverage),
+			})
+			item.SkippedCount += bucket.Count
 		}
-		return err
 	}
 
-	purgeThreshold := lastSummaryTimeBucket.Add(-*duration)
-
-	logger.Info(fmt.Sprintf("purging block summaries... [interval=%s] [older than=%s]", interval, purgeThreshold))
+	var deletedCount *int64
+	if uc.cfg.PurgeArchiveDir != "" {
+		archivedIDs, err := uc.archive(query)
+		if err != nil {
+			return nil, err
+		}
+		item.ArchivedCount = int64(len(archivedIDs))
 
-	deletedCount, err := uc.db.BlockSummary.DeleteOlderThan(interval, purgeThreshold)
-	if err != nil {
-		return err
+		// Only archived records are deleted, records which started to match query after the export are kept for the next run
+		deletedCount, err = uc.db.Retention.DeleteByIDs(query.Table, archivedIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		deletedCount, err = uc.db.Retention.DeleteCovered(query)
+		if err != nil {
+			return nil, err
+		}
 	}
+	item.DeletedCount = *deletedCount
 
-	logger.Info(fmt.Sprintf("%d block summaries purged [interval=%s]", *deletedCount, interval))
+	logger.Info(fmt.Sprintf("purged [table=%s] [time_interval=%s] [deleted=%d] [skipped=%d]", policy.Table, policy.TimeInterval, item.DeletedCount, item.SkippedCount))
 
-	return nil
+	return item, nil
 }
 
-func (uc *purgeUseCase) purgeValidatorSequences(currentIndexVersion int64) error {
-	validatorSeq, err := uc.db.ValidatorSeq.FindMostRecent()
-	if err != nil {
-		return err
-	}
-	lastSeqTime := validatorSeq.Time.Time
-
-	blockSummary, err := uc.db.ValidatorSummary.FindMostRecent()
-	if err != nil {
-		return err
-	}
-	lastSummaryTimeBucket := blockSummary.TimeBucket.Time
-
-	duration, err := uc.parseDuration(uc.cfg.PurgeSequencesInterval)
-	if err != nil {
-		if err == ErrPurgingDisabled {
-			logger.Info("purging validator sequences disabled. Purge interval set to 0.")
-		}
-		return err
-	}
+// archive writes records which are about to be purged to archive directory and returns their IDs
+func (uc *purgeUseCase) archive(query store.RetentionQuery) ([]int64, error) {
+	writer := newArchive(uc.cfg.PurgeArchiveDir).NewWriter(query.Table)
 
-	purgeThresholdFromConfig := lastSeqTime.Add(-*duration)
-
-	var purgeThreshold time.Time
-	if purgeThresholdFromConfig.Before(lastSummaryTimeBucket) {
-		purgeThreshold = purgeThresholdFromConfig
-	} else {
-		purgeThreshold = lastSummaryTimeBucket
+	var ids []int64
+	err := uc.db.Retention.ExportCovered(query, func(row store.ExportedRow) error {
+		ids = append(ids, row.ID)
+		return writer.Write(row.Day, row.Data)
+	})
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
 	}
-
-	logger.Info(fmt.Sprintf("purging validator sequences... [older than=%s]", purgeThreshold))
-
-	deletedCount, err := uc.db.ValidatorSeq.DeleteOlderThan(purgeThreshold)
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "could not archive records")
 	}
 
-	logger.Info(fmt.Sprintf("%d validator sequences purged", *deletedCount))
+	logger.Info(fmt.Sprintf("archived [table=%s] [time_interval=%s] [count=%d]", query.Table, query.TimeInterval, len(ids)))
 
-	return nil
+	return ids, nil
 }
 
-func (uc *purgeUseCase) purgeValidatorSummaries(interval types.SummaryInterval, purgeInterval string) error {
-	blockSummary, err := uc.db.ValidatorSummary.FindMostRecentByInterval(interval)
-	if err != nil {
-		return err
+// getSummarizedUntil returns time of the most recent height summarized for source table of given table
+func (uc *purgeUseCase) getSummarizedUntil(table string, currentIndexVersion int64) (*time.Time, error) {
+	source, ok := summarySources[table]
+	if !ok {
+		return nil, store.ErrCoverageNotSupported
 	}
-	lastSummaryTimeBucket := blockSummary.TimeBucket.Time
 
-	duration, err := uc.parseDuration(purgeInterval)
+	watermark, err := uc.db.SummaryWatermarks.FindBySource(source, currentIndexVersion)
 	if err != nil {
-		if err == ErrPurgingDisabled {
-			logger.Info(fmt.Sprintf("purging validator summaries disabled [interval=%s] [purge_interval=%s]", interval, purgeInterval))
-		}
-		return err
+		return nil, err
 	}
 
-	purgeThreshold := lastSummaryTimeBucket.Add(-*duration)
-
-	logger.Info(fmt.Sprintf("purging validator summaries... [interval=%s] [older than=%s]", interval, purgeThreshold))
-
-	deletedCount, err := uc.db.ValidatorSummary.DeleteOlderThan(interval, purgeThreshold)
+	syncable, err := uc.db.Syncables.FindByHeight(watermark.Height)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	logger.Info(fmt.Sprintf("%d validator summaries purged [interval=%s]", *deletedCount, interval))
-
-	return nil
+	return &syncable.Time.Time, nil
 }
 
 func (uc *purgeUseCase) parseDuration(interval string) (*time.Duration, error) {
@@ -277,7 +269,3 @@ func (uc *purgeUseCase) parseDuration(interval string) (*time.Duration, error) {
 	}
 	return &duration, nil
 }
-
-func (uc *purgeUseCase) checkErr(err error) bool {
-	return err != nil && err != ErrPurgingDisabled && err != store.ErrNotFound
-}
@@ -0,0 +1,212 @@
+package indexing
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPurgeUseCase_purge(t *testing.T) {
+	lastRecordTime := time.Date(2020, 8, 3, 12, 0, 0, 0, time.UTC)
+
+	t.Run("when coverage is required, deletes covered records and skips uncovered buckets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		watermarksMock := mock_store.NewMockSummaryWatermarksStore(ctrl)
+		syncablesMock := mock_store.NewMockSyncablesStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: retentionMock, SummaryWatermarks: watermarksMock, Syncables: syncablesMock})
+
+		policy := config.RetentionPolicy{Table: "block_sequences", Interval: "24h", MinSummaryCoverage: types.IntervalDaily}
+		wantQuery := store.RetentionQuery{
+			Table:        "block_sequences",
+			Threshold:    time.Date(2020, 8, 2, 0, 0, 0, 0, time.UTC),
+			Coverage:     types.IntervalDaily,
+			IndexVersion: testIndexVersion,
+		}
+		deletedCount := int64(10)
+
+		retentionMock.EXPECT().FindMostRecentTime("block_sequences", types.SummaryInterval("")).Return(types.NewTimeFromTime(lastRecordTime), nil).Times(1)
+		watermarksMock.EXPECT().FindBySource("block_sequences", int64(testIndexVersion)).Return(&model.SummaryWatermark{Source: "block_sequences", Height: 100}, nil).Times(1)
+		syncablesMock.EXPECT().FindByHeight(int64(100)).Return(&model.Syncable{Height: 100, Time: *types.NewTimeFromTime(lastRecordTime)}, nil).Times(1)
+		retentionMock.EXPECT().FindUncovered(wantQuery).Return([]store.UncoveredBucketRow{
+			{TimeBucket: *types.NewTimeFromTime(time.Date(2020, 7, 30, 0, 0, 0, 0, time.UTC)), Count: 7},
+		}, nil).Times(1)
+		retentionMock.EXPECT().DeleteCovered(wantQuery).Return(&deletedCount, nil).Times(1)
+
+		item, err := uc.purge(policy, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !item.Threshold.Equal(wantQuery.Threshold) {
+			t.Errorf("unexpected threshold, want %s; got %s", wantQuery.Threshold, item.Threshold)
+		}
+		if item.DeletedCount != deletedCount {
+			t.Errorf("unexpected deleted count, want %d; got %d", deletedCount, item.DeletedCount)
+		}
+		if item.SkippedCount != 7 || len(item.Skipped) != 1 {
+			t.Errorf("unexpected skipped records, want %d in 1 bucket; got %d in %d buckets", 7, item.SkippedCount, len(item.Skipped))
+		}
+	})
+
+	t.Run("when summaries are behind, deletes only records of buckets which end before summary watermark", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		watermarksMock := mock_store.NewMockSummaryWatermarksStore(ctrl)
+		syncablesMock := mock_store.NewMockSyncablesStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: retentionMock, SummaryWatermarks: watermarksMock, Syncables: syncablesMock})
+
+		policy := config.RetentionPolicy{Table: "validator_summary", TimeInterval: types.IntervalHourly, Interval: "24h", MinSummaryCoverage: types.IntervalDaily}
+		wantQuery := store.RetentionQuery{
+			Table:        "validator_summary",
+			TimeInterval: types.IntervalHourly,
+			Threshold:    time.Date(2020, 7, 31, 0, 0, 0, 0, time.UTC),
+			Coverage:     types.IntervalDaily,
+			IndexVersion: testIndexVersion,
+		}
+		deletedCount := int64(5)
+
+		retentionMock.EXPECT().FindMostRecentTime("validator_summary", types.IntervalHourly).Return(types.NewTimeFromTime(lastRecordTime), nil).Times(1)
+		watermarksMock.EXPECT().FindBySource("validator_sequences", int64(testIndexVersion)).Return(&model.SummaryWatermark{Source: "validator_sequences", Height: 50}, nil).Times(1)
+		syncablesMock.EXPECT().FindByHeight(int64(50)).Return(&model.Syncable{Height: 50, Time: *types.NewTimeFromTime(time.Date(2020, 7, 31, 10, 0, 0, 0, time.UTC))}, nil).Times(1)
+		retentionMock.EXPECT().FindUncovered(wantQuery).Return(nil, nil).Times(1)
+		retentionMock.EXPECT().DeleteCovered(wantQuery).Return(&deletedCount, nil).Times(1)
+
+		item, err := uc.purge(policy, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !item.Threshold.Equal(wantQuery.Threshold) {
+			t.Errorf("unexpected threshold, want %s; got %s", wantQuery.Threshold, item.Threshold)
+		}
+	})
+
+	t.Run("when nothing is summarized, does not touch records", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		watermarksMock := mock_store.NewMockSummaryWatermarksStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: retentionMock, SummaryWatermarks: watermarksMock})
+
+		retentionMock.EXPECT().FindMostRecentTime("balance_events", types.SummaryInterval("")).Return(types.NewTimeFromTime(lastRecordTime), nil).Times(1)
+		watermarksMock.EXPECT().FindBySource("balance_events", int64(testIndexVersion)).Return(nil, store.ErrNotFound).Times(1)
+
+		item, err := uc.purge(config.RetentionPolicy{Table: "balance_events", Interval: "24h", MinSummaryCoverage: types.IntervalDaily}, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.Reason != "no summaries" {
+			t.Errorf("unexpected reason, want %q; got %q", "no summaries", item.Reason)
+		}
+	})
+
+	t.Run("when coverage is not required, deletes all records older than interval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: retentionMock})
+
+		policy := config.RetentionPolicy{Table: "system_events", Interval: "24h"}
+		wantQuery := store.RetentionQuery{
+			Table:        "system_events",
+			Threshold:    lastRecordTime.Add(-24 * time.Hour),
+			IndexVersion: testIndexVersion,
+		}
+		deletedCount := int64(3)
+
+		retentionMock.EXPECT().FindMostRecentTime("system_events", types.SummaryInterval("")).Return(types.NewTimeFromTime(lastRecordTime), nil).Times(1)
+		retentionMock.EXPECT().DeleteCovered(wantQuery).Return(&deletedCount, nil).Times(1)
+
+		item, err := uc.purge(policy, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.DeletedCount != deletedCount || item.SkippedCount != 0 {
+			t.Errorf("unexpected counts, want deleted=%d skipped=0; got deleted=%d skipped=%d", deletedCount, item.DeletedCount, item.SkippedCount)
+		}
+	})
+
+	t.Run("when archive is enabled, deletes only archived records", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := ioutil.TempDir("", "archive")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{PurgeArchiveDir: dir}, &store.Store{Retention: retentionMock})
+
+		policy := config.RetentionPolicy{Table: "system_events", Interval: "24h"}
+		day := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+		deletedCount := int64(2)
+
+		retentionMock.EXPECT().FindMostRecentTime("system_events", types.SummaryInterval("")).Return(types.NewTimeFromTime(lastRecordTime), nil).Times(1)
+		retentionMock.EXPECT().ExportCovered(gomock.Any(), gomock.Any()).DoAndReturn(func(_ store.RetentionQuery, fn func(store.ExportedRow) error) error {
+			for _, id := range []int64{4, 7} {
+				if err := fn(store.ExportedRow{ID: id, Day: day, Data: []byte(`{}`)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Times(1)
+		retentionMock.EXPECT().DeleteByIDs("system_events", []int64{4, 7}).Return(&deletedCount, nil).Times(1)
+
+		item, err := uc.purge(policy, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.ArchivedCount != 2 || item.DeletedCount != deletedCount {
+			t.Errorf("unexpected counts, want archived=2 deleted=%d; got archived=%d deleted=%d", deletedCount, item.ArchivedCount, item.DeletedCount)
+		}
+	})
+
+	t.Run("when purging is disabled, does not touch records", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: mock_store.NewMockRetentionStore(ctrl)})
+
+		item, err := uc.purge(config.RetentionPolicy{Table: "system_events", Interval: "0"}, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.Reason != ErrPurgingDisabled.Error() {
+			t.Errorf("unexpected reason, want %q; got %q", ErrPurgingDisabled.Error(), item.Reason)
+		}
+	})
+
+	t.Run("when table is empty, reports no records", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		retentionMock := mock_store.NewMockRetentionStore(ctrl)
+		uc := NewPurgeUseCase(&config.Config{}, &store.Store{Retention: retentionMock})
+
+		retentionMock.EXPECT().FindMostRecentTime("block_summary", types.IntervalHourly).Return(nil, store.ErrNotFound).Times(1)
+
+		item, err := uc.purge(config.RetentionPolicy{Table: "block_summary", TimeInterval: types.IntervalHourly, Interval: "24h"}, testIndexVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item.Reason != "no records" {
+			t.Errorf("unexpected reason, want %q; got %q", "no records", item.Reason)
+		}
+	})
+}
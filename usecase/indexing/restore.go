@@ -0,0 +1,104 @@
+package indexing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	restoreBatchSize = 1000
+)
+
+var (
+	ErrArchiveDirRequired  = errors.New("archive directory is required")
+	ErrInvalidRestoreRange = errors.New("restore range is invalid")
+)
+
+type restoreUseCase struct {
+	cfg *config.Config
+	db  *store.Store
+}
+
+func NewRestoreUseCase(cfg *config.Config, db *store.Store) *restoreUseCase {
+	return &restoreUseCase{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+// Execute re-imports archived records of table for days between from and to (inclusive)
+func (uc *restoreUseCase) Execute(ctx context.Context, table string, from time.Time, to time.Time) error {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("restore"))
+	defer t.ObserveDuration()
+
+	if uc.cfg.PurgeArchiveDir == "" {
+		return ErrArchiveDirRequired
+	}
+	if to.Before(from) {
+		return ErrInvalidRestoreRange
+	}
+
+	a := newArchive(uc.cfg.PurgeArchiveDir)
+
+	var totalCount int64
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		count, err := uc.restoreDay(a, table, day)
+		if err != nil {
+			if os.IsNotExist(err) {
+				logger.Info(fmt.Sprintf("nothing archived [table=%s] [day=%s]", table, day.Format(archiveDayLayout)))
+				continue
+			}
+			return err
+		}
+
+		logger.Info(fmt.Sprintf("restored [table=%s] [day=%s] [count=%d]", table, day.Format(archiveDayLayout), count))
+		totalCount += count
+	}
+
+	logger.Info(fmt.Sprintf("restore completed [table=%s] [count=%d]", table, totalCount))
+
+	return nil
+}
+
+func (uc *restoreUseCase) restoreDay(a *archive, table string, day time.Time) (int64, error) {
+	var count int64
+	var batch []json.RawMessage
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		imported, err := uc.db.Retention.Import(table, batch)
+		if err != nil {
+			return err
+		}
+		count += *imported
+		batch = nil
+		return nil
+	}
+
+	err := a.Read(table, day, func(data []byte) error {
+		batch = append(batch, append(json.RawMessage{}, data...))
+		if len(batch) < restoreBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
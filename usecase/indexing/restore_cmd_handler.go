@@ -0,0 +1,57 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type RestoreCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *restoreUseCase
+}
+
+func NewRestoreCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *RestoreCmdHandler {
+	return &RestoreCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *RestoreCmdHandler) Handle(ctx context.Context, table string, from string, to string) {
+	logger.Info(fmt.Sprintf("running restore use case [handler=cmd] [table=%s] [from=%s] [to=%s]", table, from, to))
+
+	fromDay, err := time.Parse(archiveDayLayout, from)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	toDay, err := time.Parse(archiveDayLayout, to)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	err = h.getUseCase().Execute(ctx, table, fromDay, toDay)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *RestoreCmdHandler) getUseCase() *restoreUseCase {
+	if h.useCase == nil {
+		h.useCase = NewRestoreUseCase(h.cfg, h.db)
+	}
+	return h.useCase
+}
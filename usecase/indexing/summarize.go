@@ -13,6 +13,17 @@ import (
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
 )
 
+// summaryRollups lists intervals which are derived from already summarized smaller intervals.
+// Order matters since each rollup reads summaries produced by previous ones
+var summaryRollups = []struct {
+	from types.SummaryInterval
+	to   types.SummaryInterval
+}{
+	{from: types.IntervalHourly, to: types.IntervalDaily},
+	{from: types.IntervalDaily, to: types.IntervalWeekly},
+	{from: types.IntervalDaily, to: types.IntervalMonthly},
+}
+
 type summarizeUseCase struct {
 	cfg *config.Config
 	db  *store.Store
@@ -27,7 +38,7 @@ func NewSummarizeUseCase(cfg *config.Config, db *store.Store) *summarizeUseCase
 
 func (uc *summarizeUseCase) Execute(ctx context.Context) error {
 	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("summarize"))
-	t.ObserveDuration()
+	defer t.ObserveDuration()
 
 	targetsReader, err := indexer.NewConfigParser(uc.cfg.IndexerConfigFile)
 	if err != nil {
@@ -35,42 +46,79 @@ func (uc *summarizeUseCase) Execute(ctx context.Context) error {
 	}
 	currentIndexVersion := targetsReader.GetCurrentVersionId()
 
-	if err := uc.summarizeBlockSeq(types.IntervalHourly, currentIndexVersion); err != nil {
+	if err := uc.summarizeBlockSeq(currentIndexVersion); err != nil {
 		return err
 	}
 
-	if err := uc.summarizeBlockSeq(types.IntervalDaily, currentIndexVersion); err != nil {
+	if err := uc.summarizeValidatorSeq(currentIndexVersion); err != nil {
 		return err
 	}
 
-	if err := uc.summarizeValidatorSeq(types.IntervalHourly, currentIndexVersion); err != nil {
+	if err := uc.summarizeBalanceEvents(currentIndexVersion); err != nil {
 		return err
 	}
 
-	if err := uc.summarizeValidatorSeq(types.IntervalDaily, currentIndexVersion); err != nil {
-		return err
-	}
+	return nil
+}
 
-	if err := uc.summarizeBalanceEvents(types.IntervalDaily, currentIndexVersion); err != nil {
-		return err
+// getWatermark returns watermark for given source table.
+// When table has not been summarized yet for current index version, empty watermark is returned
+func (uc *summarizeUseCase) getWatermark(source string, currentIndexVersion int64) (*model.SummaryWatermark, error) {
+	watermark, err := uc.db.SummaryWatermarks.FindBySource(source, currentIndexVersion)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return &model.SummaryWatermark{
+				Source:       source,
+				IndexVersion: currentIndexVersion,
+			}, nil
+		}
+		return nil, err
 	}
-
-	return nil
+	return watermark, nil
 }
 
-func (uc *summarizeUseCase) summarizeBlockSeq(interval types.SummaryInterval, currentIndexVersion int64) error {
-	logger.Info(fmt.Sprintf("summarizing block sequences... [interval=%s]", interval))
+// summarizeBlockSeq summarizes hourly buckets which received new block sequences since last run
+// and rolls them up into larger buckets
+func (uc *summarizeUseCase) summarizeBlockSeq(currentIndexVersion int64) error {
+	logger.Info("summarizing block sequences...")
 
-	activityPeriods, err := uc.db.BlockSummary.FindActivityPeriods(interval, currentIndexVersion)
+	watermark, err := uc.getWatermark(model.BlockSeq{}.TableName(), currentIndexVersion)
 	if err != nil {
 		return err
 	}
 
-	rawSummaryItems, err := uc.db.BlockSeq.Summarize(interval, activityPeriods)
+	changes, err := uc.db.BlockSeq.FindChangesSince(watermark.Height)
 	if err != nil {
+		if err == store.ErrNotFound {
+			logger.Info(fmt.Sprintf("no new block sequences to summarize [watermark=%d]", watermark.Height))
+			return nil
+		}
 		return err
 	}
 
+	rawSummaryItems, err := uc.db.BlockSeq.Summarize(types.IntervalHourly, changes.MinTime.Time)
+	if err != nil {
+		return err
+	}
+	if err := uc.saveBlockSummaries(types.IntervalHourly, currentIndexVersion, rawSummaryItems); err != nil {
+		return err
+	}
+
+	for _, rollup := range summaryRollups {
+		rawSummaryItems, err = uc.db.BlockSummary.Rollup(rollup.from, rollup.to, changes.MinTime.Time, currentIndexVersion)
+		if err != nil {
+			return err
+		}
+		if err := uc.saveBlockSummaries(rollup.to, currentIndexVersion, rawSummaryItems); err != nil {
+			return err
+		}
+	}
+
+	watermark.Height = changes.MaxHeight
+	return uc.db.SummaryWatermarks.CreateOrUpdate(watermark)
+}
+
+func (uc *summarizeUseCase) saveBlockSummaries(interval types.SummaryInterval, currentIndexVersion int64, rawSummaryItems []store.BlockSeqSummary) error {
 	var newModels []model.BlockSummary
 	var existingModels []model.BlockSummary
 	for _, rawSummary := range rawSummaryItems {
@@ -110,24 +158,53 @@ func (uc *summarizeUseCase) summarizeBlockSeq(interval types.SummaryInterval, cu
 		}
 	}
 
-	logger.Info(fmt.Sprintf("block sequences summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
+	logger.Info(fmt.Sprintf("block sequences summarized [interval=%s] [created=%d] [updated=%d]", interval, len(newModels), len(existingModels)))
 
 	return nil
 }
 
-func (uc *summarizeUseCase) summarizeValidatorSeq(interval types.SummaryInterval, currentIndexVersion int64) error {
-	logger.Info(fmt.Sprintf("summarizing validator sequences... [interval=%s]", interval))
+// summarizeValidatorSeq summarizes hourly buckets which received new validator sequences since last run
+// and rolls them up into larger buckets
+func (uc *summarizeUseCase) summarizeValidatorSeq(currentIndexVersion int64) error {
+	logger.Info("summarizing validator sequences...")
+
+	watermark, err := uc.getWatermark(model.ValidatorSeq{}.TableName(), currentIndexVersion)
+	if err != nil {
+		return err
+	}
 
-	activityPeriods, err := uc.db.ValidatorSummary.FindActivityPeriods(interval, currentIndexVersion)
+	changes, err := uc.db.ValidatorSeq.FindChangesSince(watermark.Height)
 	if err != nil {
+		if err == store.ErrNotFound {
+			logger.Info(fmt.Sprintf("no new validator sequences to summarize [watermark=%d]", watermark.Height))
+			return nil
+		}
 		return err
 	}
 
-	rawSummaryItems, err := uc.db.ValidatorSeq.Summarize(interval, activityPeriods)
+	rawSummaryItems, err := uc.db.ValidatorSeq.Summarize(types.IntervalHourly, changes.MinTime.Time)
 	if err != nil {
 		return err
 	}
+	if err := uc.saveValidatorSummaries(types.IntervalHourly, currentIndexVersion, rawSummaryItems); err != nil {
+		return err
+	}
+
+	for _, rollup := range summaryRollups {
+		rawSummaryItems, err = uc.db.ValidatorSummary.Rollup(rollup.from, rollup.to, changes.MinTime.Time, currentIndexVersion)
+		if err != nil {
+			return err
+		}
+		if err := uc.saveValidatorSummaries(rollup.to, currentIndexVersion, rawSummaryItems); err != nil {
+			return err
+		}
+	}
 
+	watermark.Height = changes.MaxHeight
+	return uc.db.SummaryWatermarks.CreateOrUpdate(watermark)
+}
+
+func (uc *summarizeUseCase) saveValidatorSummaries(interval types.SummaryInterval, currentIndexVersion int64, rawSummaryItems []store.ValidatorSeqSummary) error {
 	var newModels []model.ValidatorSummary
 	var existingModels []model.ValidatorSummary
 	for _, rawSummary := range rawSummaryItems {
@@ -161,6 +238,9 @@ func (uc *summarizeUseCase) summarizeValidatorSeq(interval types.SummaryInterval
 					CommissionAvg:          rawSummary.CommissionAvg,
 					CommissionMax:          rawSummary.CommissionMax,
 					CommissionMin:          rawSummary.CommissionMin,
+					SharePriceAvg:          rawSummary.SharePriceAvg,
+					SharePriceMax:          rawSummary.SharePriceMax,
+					SharePriceMin:          rawSummary.SharePriceMin,
 					ValidatedSum:           rawSummary.ValidatedSum,
 					NotValidatedSum:        rawSummary.NotValidatedSum,
 					ProposedSum:            rawSummary.ProposedSum,
@@ -187,6 +267,9 @@ func (uc *summarizeUseCase) summarizeValidatorSeq(interval types.SummaryInterval
 			existingValidatorSummary.CommissionAvg = rawSummary.CommissionAvg
 			existingValidatorSummary.CommissionMax = rawSummary.CommissionMax
 			existingValidatorSummary.CommissionMin = rawSummary.CommissionMin
+			existingValidatorSummary.SharePriceAvg = rawSummary.SharePriceAvg
+			existingValidatorSummary.SharePriceMax = rawSummary.SharePriceMax
+			existingValidatorSummary.SharePriceMin = rawSummary.SharePriceMin
 			existingValidatorSummary.ValidatedSum = rawSummary.ValidatedSum
 			existingValidatorSummary.NotValidatedSum = rawSummary.NotValidatedSum
 			existingValidatorSummary.ProposedSum = rawSummary.ProposedSum
@@ -199,24 +282,53 @@ func (uc *summarizeUseCase) summarizeValidatorSeq(interval types.SummaryInterval
 		}
 	}
 
-	logger.Info(fmt.Sprintf("validator sequences summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
+	logger.Info(fmt.Sprintf("validator sequences summarized [interval=%s] [created=%d] [updated=%d]", interval, len(newModels), len(existingModels)))
 
 	return nil
 }
 
-func (uc *summarizeUseCase) summarizeBalanceEvents(interval types.SummaryInterval, currentIndexVersion int64) error {
-	logger.Info(fmt.Sprintf("summarizing balance events... [interval=%s]", interval))
+// summarizeBalanceEvents summarizes hourly buckets which received new balance events since last run
+// and rolls them up into larger buckets
+func (uc *summarizeUseCase) summarizeBalanceEvents(currentIndexVersion int64) error {
+	logger.Info("summarizing balance events...")
 
-	activityPeriods, err := uc.db.BalanceSummary.FindActivityPeriods(interval, currentIndexVersion)
+	watermark, err := uc.getWatermark(model.BalanceEvent{}.TableName(), currentIndexVersion)
 	if err != nil {
 		return err
 	}
 
-	rawSummaryItems, err := uc.db.BalanceEvents.Summarize(interval, activityPeriods)
+	changes, err := uc.db.BalanceEvents.FindChangesSince(watermark.Height)
 	if err != nil {
+		if err == store.ErrNotFound {
+			logger.Info(fmt.Sprintf("no new balance events to summarize [watermark=%d]", watermark.Height))
+			return nil
+		}
 		return err
 	}
 
+	rawSummaryItems, err := uc.db.BalanceEvents.Summarize(types.IntervalHourly, changes.MinTime.Time)
+	if err != nil {
+		return err
+	}
+	if err := uc.saveBalanceSummaries(types.IntervalHourly, currentIndexVersion, rawSummaryItems); err != nil {
+		return err
+	}
+
+	for _, rollup := range summaryRollups {
+		rawSummaryItems, err = uc.db.BalanceSummary.Rollup(rollup.from, rollup.to, changes.MinTime.Time, currentIndexVersion)
+		if err != nil {
+			return err
+		}
+		if err := uc.saveBalanceSummaries(rollup.to, currentIndexVersion, rawSummaryItems); err != nil {
+			return err
+		}
+	}
+
+	watermark.Height = changes.MaxHeight
+	return uc.db.SummaryWatermarks.CreateOrUpdate(watermark)
+}
+
+func (uc *summarizeUseCase) saveBalanceSummaries(interval types.SummaryInterval, currentIndexVersion int64, rawSummaryItems []model.BalanceSummary) error {
 	var newModels []model.BalanceSummary
 	var existingModels []model.BalanceSummary
 	for _, rawSummary := range rawSummaryItems {
@@ -234,7 +346,7 @@ func (uc *summarizeUseCase) summarizeBalanceEvents(interval types.SummaryInterva
 		if err != nil {
 			if err == store.ErrNotFound {
 				balanceSummary.Update(rawSummary)
-				if err := uc.db.BalanceEvents.Create(&balanceSummary); err != nil {
+				if err := uc.db.BalanceSummary.Create(&balanceSummary); err != nil {
 					return err
 				}
 				newModels = append(newModels, balanceSummary)
@@ -243,13 +355,13 @@ func (uc *summarizeUseCase) summarizeBalanceEvents(interval types.SummaryInterva
 			}
 		} else {
 			existingBalanceSummary.Update(rawSummary)
-			if err := uc.db.BalanceEvents.Save(existingBalanceSummary); err != nil {
+			if err := uc.db.BalanceSummary.Save(existingBalanceSummary); err != nil {
 				return err
 			}
 			existingModels = append(existingModels, *existingBalanceSummary)
 		}
 	}
 
-	logger.Info(fmt.Sprintf("balance events summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
+	logger.Info(fmt.Sprintf("balance events summarized [interval=%s] [created=%d] [updated=%d]", interval, len(newModels), len(existingModels)))
 	return nil
 }
@@ -0,0 +1,117 @@
+package indexing
+
+import (
+	"testing"
+	"time"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+const testIndexVersion = 3
+
+type summarizeMocks struct {
+	watermarks   *mock_store.MockSummaryWatermarksStore
+	blockSeq     *mock_store.MockBlockSeqStore
+	blockSummary *mock_store.MockBlockSummaryStore
+}
+
+func newTestSummarizeUseCase(ctrl *gomock.Controller) (*summarizeUseCase, summarizeMocks) {
+	mocks := summarizeMocks{
+		watermarks:   mock_store.NewMockSummaryWatermarksStore(ctrl),
+		blockSeq:     mock_store.NewMockBlockSeqStore(ctrl),
+		blockSummary: mock_store.NewMockBlockSummaryStore(ctrl),
+	}
+
+	uc := NewSummarizeUseCase(nil, &store.Store{
+		SummaryWatermarks: mocks.watermarks,
+		BlockSeq:          mocks.blockSeq,
+		BlockSummary:      mocks.blockSummary,
+	})
+	return uc, mocks
+}
+
+func TestSummarizeUseCase_summarizeBlockSeq(t *testing.T) {
+	changedAt := time.Date(2020, 8, 1, 10, 30, 0, 0, time.UTC)
+	hourlyBucket := store.BlockSeqSummary{TimeBucket: *types.NewTimeFromTime(time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)), Count: 10, BlockTimeAvg: 6}
+	dailyBucket := store.BlockSeqSummary{TimeBucket: *types.NewTimeFromTime(time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)), Count: 100, BlockTimeAvg: 6}
+
+	t.Run("when source was not summarized yet, summarizes all records and rolls them up", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc, mocks := newTestSummarizeUseCase(ctrl)
+
+		mocks.watermarks.EXPECT().FindBySource("block_sequences", int64(testIndexVersion)).Return(nil, store.ErrNotFound).Times(1)
+		mocks.blockSeq.EXPECT().FindChangesSince(int64(0)).Return(&store.ChangeSetRow{MinTime: *types.NewTimeFromTime(changedAt), MaxHeight: 20}, nil).Times(1)
+		mocks.blockSeq.EXPECT().Summarize(types.IntervalHourly, changedAt).Return([]store.BlockSeqSummary{hourlyBucket}, nil).Times(1)
+		mocks.blockSummary.EXPECT().Find(gomock.Any()).Return(nil, store.ErrNotFound).Times(2)
+		mocks.blockSummary.EXPECT().Create(gomock.Any()).Return(nil).Times(2)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalHourly, types.IntervalDaily, changedAt, int64(testIndexVersion)).Return([]store.BlockSeqSummary{dailyBucket}, nil).Times(1)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalDaily, types.IntervalWeekly, changedAt, int64(testIndexVersion)).Return(nil, nil).Times(1)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalDaily, types.IntervalMonthly, changedAt, int64(testIndexVersion)).Return(nil, nil).Times(1)
+		mocks.watermarks.EXPECT().CreateOrUpdate(&model.SummaryWatermark{Source: "block_sequences", IndexVersion: testIndexVersion, Height: 20}).Return(nil).Times(1)
+
+		if err := uc.summarizeBlockSeq(testIndexVersion); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("when source was summarized before, updates only buckets with records above watermark", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc, mocks := newTestSummarizeUseCase(ctrl)
+
+		watermark := &model.SummaryWatermark{Source: "block_sequences", IndexVersion: testIndexVersion, Height: 10}
+		existingHourly := &model.BlockSummary{Summary: &model.Summary{TimeInterval: types.IntervalHourly, TimeBucket: hourlyBucket.TimeBucket}, Count: 5}
+		existingDaily := &model.BlockSummary{Summary: &model.Summary{TimeInterval: types.IntervalDaily, TimeBucket: dailyBucket.TimeBucket}, Count: 95}
+
+		mocks.watermarks.EXPECT().FindBySource("block_sequences", int64(testIndexVersion)).Return(watermark, nil).Times(1)
+		mocks.blockSeq.EXPECT().FindChangesSince(int64(10)).Return(&store.ChangeSetRow{MinTime: *types.NewTimeFromTime(changedAt), MaxHeight: 20}, nil).Times(1)
+		mocks.blockSeq.EXPECT().Summarize(types.IntervalHourly, changedAt).Return([]store.BlockSeqSummary{hourlyBucket}, nil).Times(1)
+		gomock.InOrder(
+			mocks.blockSummary.EXPECT().Find(gomock.Any()).Return(existingHourly, nil),
+			mocks.blockSummary.EXPECT().Find(gomock.Any()).Return(existingDaily, nil),
+		)
+		mocks.blockSummary.EXPECT().Save(existingHourly).Return(nil).Times(1)
+		mocks.blockSummary.EXPECT().Save(existingDaily).Return(nil).Times(1)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalHourly, types.IntervalDaily, changedAt, int64(testIndexVersion)).Return([]store.BlockSeqSummary{dailyBucket}, nil).Times(1)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalDaily, types.IntervalWeekly, changedAt, int64(testIndexVersion)).Return(nil, nil).Times(1)
+		mocks.blockSummary.EXPECT().Rollup(types.IntervalDaily, types.IntervalMonthly, changedAt, int64(testIndexVersion)).Return(nil, nil).Times(1)
+		mocks.watermarks.EXPECT().CreateOrUpdate(watermark).Return(nil).Times(1)
+
+		if err := uc.summarizeBlockSeq(testIndexVersion); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if watermark.Height != 20 {
+			t.Errorf("unexpected watermark height, want %d; got %d", 20, watermark.Height)
+		}
+		if existingHourly.Count != hourlyBucket.Count {
+			t.Errorf("unexpected hourly count, want %d; got %d", hourlyBucket.Count, existingHourly.Count)
+		}
+		if existingDaily.Count != dailyBucket.Count {
+			t.Errorf("unexpected daily count, want %d; got %d", dailyBucket.Count, existingDaily.Count)
+		}
+	})
+
+	t.Run("when there are no records above watermark, does not move watermark", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc, mocks := newTestSummarizeUseCase(ctrl)
+
+		watermark := &model.SummaryWatermark{Source: "block_sequences", IndexVersion: testIndexVersion, Height: 20}
+
+		mocks.watermarks.EXPECT().FindBySource("block_sequences", int64(testIndexVersion)).Return(watermark, nil).Times(1)
+		mocks.blockSeq.EXPECT().FindChangesSince(int64(20)).Return(nil, store.ErrNotFound).Times(1)
+
+		if err := uc.summarizeBlockSeq(testIndexVersion); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,357 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/apikey"
+	apihttp "github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	pathParamRegexp = regexp.MustCompile(`:(\w+)`)
+
+	timeType     = reflect.TypeOf(time.Time{})
+	typesTime    = reflect.TypeOf(types.Time{})
+	quantityType = reflect.TypeOf(types.Quantity{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+	jsonbType    = reflect.TypeOf(types.Jsonb{})
+	rawJsonType  = reflect.TypeOf(json.RawMessage{})
+
+	paginatedViewType = reflect.TypeOf((*apihttp.PaginatedView)(nil)).Elem()
+)
+
+// generator builds OpenAPI document from request and response types of operations
+type generator struct {
+	schemas map[string]*Schema
+}
+
+func newGenerator() *generator {
+	return &generator{
+		schemas: map[string]*Schema{},
+	}
+}
+
+// Generate returns OpenAPI document describing given operations
+func Generate(info Info, operations []Operation) *Document {
+	g := newGenerator()
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]*PathItem{},
+	}
+
+	for _, op := range operations {
+		p := ToPath(op.Path)
+		item, ok := doc.Paths[p]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[p] = item
+		}
+		(*item)[strings.ToLower(op.Method)] = g.operation(op)
+	}
+
+	doc.Components = Components{Schemas: g.schemas, SecuritySchemes: securitySchemes}
+	return doc
+}
+
+var securitySchemes = map[string]*SecurityScheme{
+	"apiKey": {Type: "apiKey", In: "header", Name: apikey.APIKeyHeader, Description: "API key"},
+	"bearer": {Type: "http", Scheme: "bearer", Description: "API key as bearer token"},
+}
+
+// ToPath converts gin route path to OpenAPI path, ie. /validator/:address to /validator/{address}
+func ToPath(ginPath string) string {
+	return pathParamRegexp.ReplaceAllString(ginPath, "{$1}")
+}
+
+func (g *generator) operation(op Operation) *OperationObject {
+	obj := &OperationObject{
+		OperationID: op.ID,
+		Tags:        []string{op.Tag},
+		Summary:     op.Summary,
+		Description: op.Description,
+		Deprecated:  op.Deprecated,
+		Responses:   map[string]*Response{},
+	}
+
+	if op.Scope != "" {
+		// Empty requirement allows anonymous access, which depends on server configuration
+		obj.Security = []SecurityRequirement{{"apiKey": {}}, {"bearer": {}}, {}}
+		obj.Description = strings.TrimSpace(obj.Description + " Requires " + op.Scope.String() + " scope.")
+	}
+
+	if op.Request != nil {
+		obj.Parameters, obj.RequestBody = g.request(op.Method, reflect.TypeOf(op.Request))
+	}
+
+	contentType := op.ContentType
+	if contentType == "" {
+		contentType = ContentTypeJSON
+	}
+
+	okResponse := &Response{Description: "OK"}
+	switch {
+	case contentType != ContentTypeJSON:
+		okResponse.Content = map[string]*MediaType{contentType: {Schema: &Schema{Type: "string"}}}
+	case op.Versioned:
+		okResponse.Content = map[string]*MediaType{contentType: {Schema: g.envelopeSchema(op.Response)}}
+	default:
+		okResponse.Content = map[string]*MediaType{contentType: {Schema: g.responseSchema(op.Response)}}
+	}
+	obj.Responses["200"] = okResponse
+
+	errorSchema := g.schemaOf(reflect.TypeOf(ErrorView{}))
+	if op.Versioned {
+		errorSchema = g.errorEnvelopeSchema()
+	}
+	for _, code := range op.errorCodes() {
+		obj.Responses[fmt.Sprint(code)] = &Response{
+			Description: http.StatusText(code),
+			Content:     map[string]*MediaType{ContentTypeJSON: {Schema: errorSchema}},
+		}
+	}
+
+	return obj
+}
+
+func (g *generator) responseSchema(response interface{}) *Schema {
+	if oneOf, ok := response.(OneOf); ok {
+		schema := &Schema{}
+		for _, r := range oneOf {
+			schema.OneOf = append(schema.OneOf, g.schemaOf(reflect.TypeOf(r)))
+		}
+		return schema
+	}
+	if response == nil {
+		return &Schema{}
+	}
+	return g.schemaOf(reflect.TypeOf(response))
+}
+
+// envelopeSchema returns schema of response rendered in envelope of versioned API.
+// Items of paginated views are rendered as data and their cursor as pagination
+func (g *generator) envelopeSchema(response interface{}) *Schema {
+	data := response
+	pagination := &Schema{Nullable: true}
+	if view, ok := paginatedView(response); ok {
+		data = view.PageItems()
+		pagination = g.schemaOf(reflect.TypeOf(apihttp.PaginationMeta{}))
+	}
+
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":       g.responseSchema(data),
+			"meta":       g.schemaOf(reflect.TypeOf(apihttp.Meta{})),
+			"pagination": pagination,
+		},
+	}
+}
+
+func (g *generator) errorEnvelopeSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":       {Nullable: true},
+			"meta":       g.schemaOf(reflect.TypeOf(apihttp.Meta{})),
+			"pagination": {Nullable: true},
+			"error":      g.schemaOf(reflect.TypeOf(apihttp.ErrorBody{})),
+		},
+	}
+}
+
+// paginatedView returns view implementing PaginatedView with value or pointer receiver
+func paginatedView(response interface{}) (apihttp.PaginatedView, bool) {
+	if response == nil {
+		return nil, false
+	}
+	if view, ok := response.(apihttp.PaginatedView); ok {
+		return view, true
+	}
+
+	t := reflect.TypeOf(response)
+	if t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(paginatedViewType) {
+		return reflect.New(t).Interface().(apihttp.PaginatedView), true
+	}
+	return nil, false
+}
+
+// request returns path and query parameters from uri and form tags
+// and request body from json tags of request type
+func (g *generator) request(method string, t reflect.Type) ([]*Parameter, *RequestBody) {
+	var params []*Parameter
+	body := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	hasBody := method != http.MethodGet
+
+	forEachField(t, func(f reflect.StructField) {
+		required := strings.Contains(f.Tag.Get("binding"), "required")
+
+		if name := tagName(f, "uri"); name != "" {
+			params = append(params, &Parameter{Name: name, In: "path", Required: true, Schema: g.paramSchema(f)})
+			return
+		}
+		if _, ok := f.Tag.Lookup("json"); ok && hasBody {
+			name := tagName(f, "json")
+			if name != "" {
+				body.Properties[name] = g.schemaOf(f.Type)
+				if required {
+					body.Required = append(body.Required, name)
+				}
+			}
+			return
+		}
+		if name := tagName(f, "form"); name != "" {
+			params = append(params, &Parameter{Name: name, In: "query", Required: required, Schema: g.paramSchema(f)})
+		}
+	})
+
+	if len(body.Properties) == 0 {
+		return params, nil
+	}
+	return params, &RequestBody{
+		Required: len(body.Required) > 0,
+		Content:  map[string]*MediaType{ContentTypeJSON: {Schema: body}},
+	}
+}
+
+func (g *generator) paramSchema(f reflect.StructField) *Schema {
+	schema := g.schemaOf(f.Type)
+	if f.Tag.Get("time_format") == "2006-01-02" {
+		schema.Format = "date"
+	}
+	schema.Nullable = false
+	return schema
+}
+
+// schemaOf returns schema of type following encoding/json rules.
+// Named structs are added to components and referenced
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case timeType, typesTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case quantityType, bigIntType:
+		return &Schema{Type: "integer", Description: "arbitrary precision integer"}
+	case jsonbType, rawJsonType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := g.schemaOf(t.Elem())
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+		return schema
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	name := schemaName(t)
+	if name != "" {
+		if _, ok := g.schemas[name]; ok {
+			return &Schema{Ref: "#/components/schemas/" + name}
+		}
+	}
+
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	if name != "" {
+		// Register before fields are resolved, so recursive types are referenced
+		g.schemas[name] = schema
+	}
+
+	forEachField(t, func(f reflect.StructField) {
+		if name := tagName(f, "json"); name != "" {
+			schema.Properties[name] = g.schemaOf(f.Type)
+		}
+	})
+
+	if name == "" {
+		return schema
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// forEachField calls fn for exported fields of struct including fields of embedded structs
+func forEachField(t reflect.Type, fn func(f reflect.StructField)) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				forEachField(ft, fn)
+				continue
+			}
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+		fn(f)
+	}
+}
+
+// tagName returns name from struct tag or empty string when field is skipped
+func tagName(f reflect.StructField, key string) string {
+	tag, ok := f.Tag.Lookup(key)
+	if !ok {
+		if key == "json" {
+			return f.Name
+		}
+		return ""
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" && key == "json" {
+		return f.Name
+	}
+	return name
+}
+
+// schemaName returns component name of named type, ie. validator.AggDetailsView
+func schemaName(t reflect.Type) string {
+	if t.Name() == "" {
+		return ""
+	}
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
@@ -0,0 +1,171 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type testRequest struct {
+	Address string  `uri:"address" binding:"required"`
+	Height  *int64  `form:"height" binding:"-"`
+	Kind    string  `form:"kind" binding:"required"`
+	Note    *string `json:"note" binding:"-"`
+	Reason  string  `json:"reason" binding:"required"`
+
+	testPagination
+}
+
+type testPagination struct {
+	Limit int64 `form:"limit" binding:"-"`
+}
+
+type testView struct {
+	*model.Model
+	*model.Sequence
+
+	Amount  types.Quantity `json:"amount"`
+	Items   []testItem     `json:"items"`
+	Cursor  *string        `json:"next_cursor"`
+	Skipped string         `json:"-"`
+}
+
+type testItem struct {
+	Data types.Jsonb `json:"data"`
+}
+
+func (v testView) PageItems() interface{} {
+	return v.Items
+}
+
+func (v testView) PageNextCursor() *string {
+	return v.Cursor
+}
+
+func TestToPath(t *testing.T) {
+	path := ToPath("/webhook_subscriptions/:id/deliveries")
+	if path != "/webhook_subscriptions/{id}/deliveries" {
+		t.Errorf("unexpected path: %v", path)
+	}
+}
+
+func TestGenerator_Request(t *testing.T) {
+	t.Run("GET request", func(t *testing.T) {
+		params, body := newGenerator().request(http.MethodGet, reflect.TypeOf(testRequest{}))
+
+		if body != nil {
+			t.Errorf("unexpected request body: %v", body)
+		}
+
+		expected := []Parameter{
+			{Name: "address", In: "path", Required: true},
+			{Name: "height", In: "query"},
+			{Name: "kind", In: "query", Required: true},
+			{Name: "limit", In: "query"},
+		}
+		if len(params) != len(expected) {
+			t.Fatalf("unexpected parameter count, want %v; got %v", len(expected), len(params))
+		}
+		for i, param := range params {
+			if param.Name != expected[i].Name || param.In != expected[i].In || param.Required != expected[i].Required {
+				t.Errorf("unexpected parameter, want %+v; got %+v", expected[i], *param)
+			}
+		}
+	})
+
+	t.Run("POST request", func(t *testing.T) {
+		params, body := newGenerator().request(http.MethodPost, reflect.TypeOf(testRequest{}))
+
+		if len(params) != 4 {
+			t.Errorf("unexpected parameter count, want %v; got %v", 4, len(params))
+		}
+		if body == nil {
+			t.Fatal("expected request body")
+		}
+
+		schema := body.Content[ContentTypeJSON].Schema
+		if len(schema.Properties) != 2 || !schema.Properties["note"].Nullable {
+			t.Errorf("unexpected body properties: %v", schema.Properties)
+		}
+		if !reflect.DeepEqual(schema.Required, []string{"reason"}) {
+			t.Errorf("unexpected required properties: %v", schema.Required)
+		}
+	})
+}
+
+func TestGenerator_SchemaOf(t *testing.T) {
+	g := newGenerator()
+
+	schema := g.schemaOf(reflect.TypeOf(testView{}))
+	if schema.Ref != "#/components/schemas/openapi.testView" {
+		t.Fatalf("unexpected ref: %v", schema.Ref)
+	}
+
+	view := g.schemas["openapi.testView"]
+	for _, name := range []string{"id", "created_at", "updated_at", "height", "time", "amount", "items", "next_cursor"} {
+		if _, ok := view.Properties[name]; !ok {
+			t.Errorf("expected property %s", name)
+		}
+	}
+	if _, ok := view.Properties["Skipped"]; ok {
+		t.Error("unexpected skipped property")
+	}
+
+	if view.Properties["amount"].Type != "integer" {
+		t.Errorf("unexpected amount type: %v", view.Properties["amount"].Type)
+	}
+	if view.Properties["time"].Format != "date-time" {
+		t.Errorf("unexpected time format: %v", view.Properties["time"].Format)
+	}
+	if view.Properties["items"].Items.Ref != "#/components/schemas/openapi.testItem" {
+		t.Errorf("unexpected items ref: %v", view.Properties["items"].Items.Ref)
+	}
+	if !view.Properties["next_cursor"].Nullable {
+		t.Error("expected nullable next cursor")
+	}
+}
+
+func TestGenerator_EnvelopeSchema(t *testing.T) {
+	t.Run("paginated view", func(t *testing.T) {
+		schema := newGenerator().envelopeSchema(testView{})
+
+		data := schema.Properties["data"]
+		if data.Type != "array" || data.Items.Ref != "#/components/schemas/openapi.testItem" {
+			t.Errorf("unexpected data schema: %+v", data)
+		}
+		if schema.Properties["pagination"].Ref != "#/components/schemas/http.PaginationMeta" {
+			t.Errorf("unexpected pagination schema: %+v", schema.Properties["pagination"])
+		}
+	})
+
+	t.Run("details view", func(t *testing.T) {
+		schema := newGenerator().envelopeSchema(testItem{})
+
+		if schema.Properties["data"].Ref != "#/components/schemas/openapi.testItem" {
+			t.Errorf("unexpected data schema: %+v", schema.Properties["data"])
+		}
+		if schema.Properties["meta"].Ref != "#/components/schemas/http.Meta" {
+			t.Errorf("unexpected meta schema: %+v", schema.Properties["meta"])
+		}
+		if !schema.Properties["pagination"].Nullable {
+			t.Error("expected nullable pagination")
+		}
+	})
+}
+
+func TestWithVersion(t *testing.T) {
+	ops := withVersion("v1", []Operation{{ID: "GetBlock", Method: http.MethodGet, Path: "/block"}})
+
+	if len(ops) != 2 {
+		t.Fatalf("unexpected operation count, want %v; got %v", 2, len(ops))
+	}
+	if ops[0].ID != "GetBlock" || ops[0].Path != "/v1/block" || !ops[0].Versioned || ops[0].Deprecated {
+		t.Errorf("unexpected versioned operation: %+v", ops[0])
+	}
+	if ops[1].ID != "GetBlockLegacy" || ops[1].Path != "/block" || ops[1].Versioned || !ops[1].Deprecated {
+		t.Errorf("unexpected deprecated operation: %+v", ops[1])
+	}
+}
@@ -0,0 +1,28 @@
+package openapi
+
+import (
+	"sync"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+)
+
+type getSpecUseCase struct {
+	once sync.Once
+	doc  *Document
+}
+
+func NewGetSpecUseCase() *getSpecUseCase {
+	return &getSpecUseCase{}
+}
+
+// Execute returns OpenAPI document of HTTP API. Document is generated only once
+func (uc *getSpecUseCase) Execute() *Document {
+	uc.once.Do(func() {
+		uc.doc = Generate(Info{
+			Title:       config.AppName,
+			Description: "HTTP API of Oasis indexer",
+			Version:     config.AppVersion,
+		}, Operations)
+	})
+	return uc.doc
+}
@@ -0,0 +1,30 @@
+package openapi
+
+import (
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getSpecHttpHandler)(nil)
+)
+
+type getSpecHttpHandler struct {
+	useCase *getSpecUseCase
+}
+
+func NewGetSpecHttpHandler() *getSpecHttpHandler {
+	return &getSpecHttpHandler{}
+}
+
+func (h *getSpecHttpHandler) Handle(c *gin.Context) {
+	http.JsonOK(c, h.getUseCase().Execute())
+}
+
+func (h *getSpecHttpHandler) getUseCase() *getSpecUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSpecUseCase()
+	}
+	return h.useCase
+}
@@ -0,0 +1,188 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/account"
+	"github.com/figment-networks/oasishub-indexer/usecase/balance"
+	"github.com/figment-networks/oasishub-indexer/usecase/block"
+	"github.com/figment-networks/oasishub-indexer/usecase/chain"
+	"github.com/figment-networks/oasishub-indexer/usecase/debondingdelegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/delegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/graphql"
+	apihttp "github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/usecase/portfolio"
+	"github.com/figment-networks/oasishub-indexer/usecase/staking"
+	"github.com/figment-networks/oasishub-indexer/usecase/stream"
+	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/transaction"
+	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
+	gographql "github.com/graph-gophers/graphql-go"
+)
+
+// Operation describes HTTP API route with its request and response types.
+// Request type fields are documented from uri, form and json tags, response type from json tags
+type Operation struct {
+	ID          string
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Versioned   bool
+	ContentType string
+	// Scope of api key required by route, empty for public routes
+	Scope model.APIKeyScope
+
+	Request  interface{}
+	Response interface{}
+}
+
+// OneOf is response of operation which returns one of given types
+type OneOf []interface{}
+
+// ErrorView is rendered by error responders
+type ErrorView struct {
+	Status int    `json:"status"`
+	Error  string `json:"error"`
+}
+
+func (op Operation) errorCodes() []int {
+	if op.ContentType != "" && op.ContentType != ContentTypeJSON {
+		return nil
+	}
+
+	var codes []int
+	if op.Request != nil {
+		codes = append(codes, http.StatusBadRequest)
+	}
+	if op.Scope != "" {
+		codes = append(codes, http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests)
+	}
+	// Versioned API responds with not found also for heights which are not indexed yet
+	if strings.Contains(op.Path, ":") || (op.Versioned && op.Request != nil) {
+		codes = append(codes, http.StatusNotFound)
+	}
+	return append(codes, http.StatusInternalServerError)
+}
+
+// Operations lists all routes of HTTP API. Every route registered in server/routes.go has to be listed here
+var Operations = append(unversionedOperations, withVersion(apihttp.APIVersion1, resourceOperations)...)
+
+var unversionedOperations = []Operation{
+	{ID: "Health", Method: http.MethodGet, Path: "/health", Tag: "status", Summary: "health check", ContentType: ContentTypeText},
+	{ID: "GetOpenAPISpec", Method: http.MethodGet, Path: "/openapi.json", Tag: "status", Summary: "OpenAPI specification of HTTP API", Response: map[string]interface{}{}},
+	{ID: "GetSwaggerUI", Method: http.MethodGet, Path: "/docs", Tag: "status", Summary: "Swagger UI for OpenAPI specification", ContentType: ContentTypeHTML},
+
+	{ID: "Stream", Method: http.MethodGet, Path: "/stream", Tag: "streaming", Summary: "server-sent events with records of processed heights",
+		Request: stream.Request{}, ContentType: ContentTypeEventStream, Scope: model.APIKeyScopeRead},
+	{ID: "ExecuteGraphQL", Method: http.MethodPost, Path: "/graphql", Tag: "graphql", Summary: "execute GraphQL query",
+		Request: graphql.Request{}, Response: gographql.Response{}, Scope: model.APIKeyScopeRead},
+}
+
+const webhookDescription = "Anonymous access is not allowed. Subscriptions are accessible only with api key which created them"
+
+// resourceOperations are served under version prefix and as deprecated unversioned aliases.
+// Operations without scope require read scope
+var resourceOperations = []Operation{
+	{ID: "GetStatus", Method: http.MethodGet, Path: "/status", Tag: "status", Summary: "status of the application and chain", Response: chain.DetailsView{}},
+
+	{ID: "GetBlockByHeight", Method: http.MethodGet, Path: "/block", Tag: "blocks", Summary: "block details for height", Description: "Height defaults to the most recent indexed height",
+		Request: block.Request{}, Response: block.DetailsView{}},
+	{ID: "GetBlockTimes", Method: http.MethodGet, Path: "/block_times/:limit", Tag: "blocks", Summary: "average block times for limit of recent blocks",
+		Request: block.GetBlockTimesRequest{}, Response: store.GetAvgRecentTimesResult{}},
+	{ID: "GetBlockSummary", Method: http.MethodGet, Path: "/blocks_summary", Tag: "blocks", Summary: "block summaries for interval and period",
+		Request: block.GetBlockTimesForIntervalRequest{}, Response: []model.BlockSummary{}},
+
+	{ID: "GetTransactionsByHeight", Method: http.MethodGet, Path: "/transactions", Tag: "transactions", Summary: "transactions for height",
+		Request: transaction.Request{}, Response: transaction.ListView{}},
+	{ID: "BroadcastTransaction", Method: http.MethodPost, Path: "/transactions", Tag: "transactions", Summary: "broadcast raw transaction",
+		Request: transaction.BroadcastRequest{}, Response: transaction.BroadcastResponse{}, Scope: model.APIKeyScopeBroadcast},
+
+	{ID: "GetValidatorByAddress", Method: http.MethodGet, Path: "/validator/:address", Tag: "validators", Summary: "validator aggregate with its last sequences",
+		Request: validator.GetByEntityUidRequest{}, Response: validator.AggDetailsView{}},
+	{ID: "GetValidatorReturns", Method: http.MethodGet, Path: "/validator/:address/returns", Tag: "validators", Summary: "realized reward rate of validator", Description: "Reward rate is net of commission and annualized. Network median is included for comparison",
+		Request: validator.GetReturnsRequest{}, Response: validator.ReturnsView{}},
+	{ID: "GetValidatorSharePrice", Method: http.MethodGet, Path: "/validator/:address/share_price", Tag: "validators", Summary: "share price of validator escrow pool for interval and period", Description: "Interval defaults to day and period to 30 days. Period is a positive number of hours, days, weeks, months or years",
+		Request: validator.GetSharePriceRequest{}, Response: validator.SharePriceView{}},
+	{ID: "GetValidatorsForMinHeight", Method: http.MethodGet, Path: "/validators/for_min_height/:height", Tag: "validators", Summary: "validators seen at or after height",
+		Request: validator.GetForMinHeightRequest{}, Response: validator.AggListView{}},
+	{ID: "GetValidatorLeaderboard", Method: http.MethodGet, Path: "/validators/leaderboard", Tag: "validators", Summary: "validators ranked by metric", Description: "Rank changes compare with ranking 24 hours and 7 days ago. Estimated APR annualizes rewards of last 30 days",
+		Request: validator.GetLeaderboardRequest{}, Response: validator.LeaderboardView{}},
+	{ID: "GetValidatorsByHeight", Method: http.MethodGet, Path: "/validators", Tag: "validators", Summary: "validator sequences for height",
+		Request: validator.GetByHeightRequest{}, Response: validator.SeqListView{}},
+	{ID: "GetValidatorSummary", Method: http.MethodGet, Path: "/validators_summary", Tag: "validators", Summary: "validator summaries for interval and period", Description: "Summaries are grouped by validator when address is provided",
+		Request: validator.GetSummaryRequest{}, Response: OneOf{[]store.ValidatorSummaryRow{}, []model.ValidatorSummary{}}},
+	{ID: "MuteValidator", Method: http.MethodPost, Path: "/validators/:address/mute", Tag: "validators", Summary: "mute system events of validator",
+		Request: struct {
+			validator.ValidatorRequest
+			validator.MuteRequest
+		}{}, Response: model.ValidatorMute{}, Scope: model.APIKeyScopeWrite},
+
+	{ID: "GetStakingDetailsByHeight", Method: http.MethodGet, Path: "/staking", Tag: "staking", Summary: "staking details for height",
+		Request: staking.Request{}, Response: staking.DetailsView{}},
+	{ID: "GetDelegationsByHeight", Method: http.MethodGet, Path: "/delegations", Tag: "staking", Summary: "delegations for height",
+		Request: delegation.Request{}, Response: delegation.ListView{}},
+	{ID: "GetDelegationsByAddress", Method: http.MethodGet, Path: "/delegations/:address", Tag: "staking", Summary: "delegations of address",
+		Request: delegation.GetByAddressRequest{}, Response: delegation.ListView{}},
+	{ID: "GetDebondingDelegationsByHeight", Method: http.MethodGet, Path: "/debonding_delegations", Tag: "staking", Summary: "debonding delegations for height",
+		Request: debondingdelegation.Request{}, Response: debondingdelegation.ListView{}},
+	{ID: "GetDebondingDelegationsByAddress", Method: http.MethodGet, Path: "/debonding_delegations/:address", Tag: "staking", Summary: "debonding delegations of address",
+		Request: debondingdelegation.GetByAddressRequest{}, Response: debondingdelegation.ListView{}},
+
+	{ID: "GetAccountByAddress", Method: http.MethodGet, Path: "/account/:address", Tag: "accounts", Summary: "account details",
+		Request: account.Request{}, Response: account.DetailsView{}},
+	{ID: "GetBalanceForAddress", Method: http.MethodGet, Path: "/balance/:address", Tag: "accounts", Summary: "balance summaries of address", Description: "Interval defaults to day",
+		Request: balance.GetForAddressRequest{}, Response: []model.BalanceSummary{}},
+	{ID: "GetPortfolioByAddress", Method: http.MethodGet, Path: "/portfolio/:address", Tag: "accounts", Summary: "general balance, delegations, debonding delegations and rewards of address", Description: "Unlock of debonding delegations is estimated from debond end epoch, EPOCH_INTERVAL and average block time",
+		Request: portfolio.Request{}, Response: portfolio.DetailsView{}},
+
+	{ID: "GetSystemEventsForNetwork", Method: http.MethodGet, Path: "/system_events", Tag: "system events", Summary: "network system events",
+		Request: systemevent.GetForNetworkRequest{}, Response: systemevent.ListView{}},
+	{ID: "GetSystemEventsForAddress", Method: http.MethodGet, Path: "/system_events/:address", Tag: "system events", Summary: "system events of address",
+		Request: systemevent.GetForAddressRequest{}, Response: systemevent.ListView{}},
+	{ID: "AcknowledgeSystemEvent", Method: http.MethodPost, Path: "/system_events/:id/ack", Tag: "system events", Summary: "acknowledge system event",
+		Request: struct {
+			systemevent.SystemEventRequest
+			systemevent.AcknowledgeRequest
+		}{}, Response: model.SystemEventAck{}, Scope: model.APIKeyScopeWrite},
+
+	{ID: "GetWebhookSubscriptions", Method: http.MethodGet, Path: "/webhook_subscriptions", Tag: "webhooks", Summary: "webhook subscriptions of api key", Description: webhookDescription,
+		Response: webhook.SubscriptionListView{}, Scope: model.APIKeyScopeWrite},
+	{ID: "CreateWebhookSubscription", Method: http.MethodPost, Path: "/webhook_subscriptions", Tag: "webhooks", Summary: "create webhook subscription", Description: webhookDescription,
+		Request: webhook.CreateSubscriptionRequest{}, Response: webhook.SubscriptionCreatedView{}, Scope: model.APIKeyScopeWrite},
+	{ID: "DeleteWebhookSubscription", Method: http.MethodDelete, Path: "/webhook_subscriptions/:id", Tag: "webhooks", Summary: "delete webhook subscription", Description: webhookDescription,
+		Request: webhook.SubscriptionRequest{}, Response: map[string]bool{}, Scope: model.APIKeyScopeWrite},
+	{ID: "GetWebhookDeliveries", Method: http.MethodGet, Path: "/webhook_subscriptions/:id/deliveries", Tag: "webhooks", Summary: "deliveries of webhook subscription", Description: webhookDescription,
+		Request: struct {
+			webhook.SubscriptionRequest
+			webhook.GetDeliveriesRequest
+		}{}, Response: webhook.DeliveryListView{}, Scope: model.APIKeyScopeWrite},
+}
+
+// withVersion returns operations prefixed with version followed by their deprecated unversioned aliases
+func withVersion(version string, operations []Operation) []Operation {
+	var versioned, legacy []Operation
+	for _, op := range operations {
+		if op.Scope == "" {
+			op.Scope = model.APIKeyScopeRead
+		}
+
+		v := op
+		v.Path = "/" + version + op.Path
+		v.Versioned = true
+		versioned = append(versioned, v)
+
+		l := op
+		l.ID = op.ID + "Legacy"
+		l.Deprecated = true
+		l.Description = strings.TrimSpace(op.Description + " Deprecated, use /" + version + op.Path + " instead.")
+		legacy = append(legacy, l)
+	}
+	return append(versioned, legacy...)
+}
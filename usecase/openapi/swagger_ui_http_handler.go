@@ -0,0 +1,38 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/gin-gonic/gin"
+)
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>oasishub-indexer API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@3/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@3/swagger-ui-bundle.js"></script>
+  <script>
+    SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>`
+
+var (
+	_ types.HttpHandler = (*swaggerUIHttpHandler)(nil)
+)
+
+type swaggerUIHttpHandler struct{}
+
+func NewSwaggerUIHttpHandler() *swaggerUIHttpHandler {
+	return &swaggerUIHttpHandler{}
+}
+
+// Handle renders Swagger UI page which loads specification from /openapi.json
+func (h *swaggerUIHttpHandler) Handle(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
@@ -0,0 +1,68 @@
+package portfolio
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+// shareValue returns amount of tokens of shares in escrow pool with given balance and total shares
+func shareValue(shares types.Quantity, balance types.Quantity, totalShares types.Quantity) types.Quantity {
+	if totalShares.Sign() <= 0 {
+		return types.NewQuantityFromInt64(0)
+	}
+
+	value := new(big.Int).Mul(&shares.Int, &balance.Int)
+	return types.NewQuantity(value.Quo(value, &totalShares.Int))
+}
+
+// epochSchedule maps heights to epochs. Epochs are interval blocks long and are counted from base epoch at genesis height
+type epochSchedule struct {
+	GenesisHeight int64
+	Base          uint64
+	Interval      int64
+}
+
+// EpochAt returns epoch of given height
+func (s epochSchedule) EpochAt(height int64) uint64 {
+	if height <= s.GenesisHeight {
+		return s.Base
+	}
+	return s.Base + uint64((height-s.GenesisHeight)/s.Interval)
+}
+
+// StartHeight returns first height of given epoch
+func (s epochSchedule) StartHeight(epoch uint64) int64 {
+	if epoch <= s.Base {
+		return s.GenesisHeight
+	}
+	return s.GenesisHeight + int64(epoch-s.Base)*s.Interval
+}
+
+// unlockEstimate contains estimated epochs left and height and time at which debonding delegation can be reclaimed
+type unlockEstimate struct {
+	EpochsLeft int64
+	Height     int64
+	Time       time.Time
+}
+
+// estimateUnlock estimates unlock of debonding delegation ending at given epoch.
+// Debonding delegation can be reclaimed from the first height of its debond end epoch, blocks left until then are converted
+// to time with average block time
+func estimateUnlock(debondEnd uint64, schedule epochSchedule, currentHeight int64, currentTime time.Time, blockTime time.Duration) unlockEstimate {
+	estimate := unlockEstimate{
+		Height: currentHeight,
+		Time:   currentTime,
+	}
+
+	currentEpoch := schedule.EpochAt(currentHeight)
+	if debondEnd <= currentEpoch {
+		return estimate
+	}
+
+	estimate.EpochsLeft = int64(debondEnd - currentEpoch)
+	estimate.Height = schedule.StartHeight(debondEnd)
+	estimate.Time = currentTime.Add(time.Duration(estimate.Height-currentHeight) * blockTime)
+	return estimate
+}
@@ -0,0 +1,95 @@
+package portfolio
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+func TestShareValue(t *testing.T) {
+	tests := []struct {
+		description string
+		shares      int64
+		balance     int64
+		totalShares int64
+		want        int64
+	}{
+		{"values shares at pool share price", 50, 300, 100, 150},
+		{"rounds down", 1, 10, 3, 3},
+		{"returns 0 for empty pool", 50, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := shareValue(quantity(tt.shares), quantity(tt.balance), quantity(tt.totalShares))
+			if got.Int64() != tt.want {
+				t.Errorf("unexpected value, want %d; got %s", tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestEpochSchedule(t *testing.T) {
+	schedule := epochSchedule{GenesisHeight: 1000, Base: 5, Interval: 100}
+
+	tests := []struct {
+		description string
+		height      int64
+		epoch       uint64
+		startHeight int64
+	}{
+		{"returns base epoch at genesis height", 1000, 5, 1000},
+		{"returns epoch of height within epoch", 1150, 6, 1100},
+		{"returns next epoch at its first height", 1200, 7, 1200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := schedule.EpochAt(tt.height); got != tt.epoch {
+				t.Errorf("unexpected epoch, want %d; got %d", tt.epoch, got)
+			}
+			if got := schedule.StartHeight(tt.epoch); got != tt.startHeight {
+				t.Errorf("unexpected start height, want %d; got %d", tt.startHeight, got)
+			}
+		})
+	}
+}
+
+func TestEstimateUnlock(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := epochSchedule{GenesisHeight: 150, Base: 0, Interval: 100}
+
+	tests := []struct {
+		description string
+		debondEnd   uint64
+		epochsLeft  int64
+		height      int64
+		time        time.Time
+	}{
+		{"estimates remaining epochs, height and time", 12, 2, 1350, now.Add(170 * 6 * time.Second)},
+		{"estimates height at start of the next epoch", 11, 1, 1250, now.Add(70 * 6 * time.Second)},
+		{"returns current height and time when debonding ended", 9, 0, 1180, now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			// Height 1180 is 30 blocks into epoch 10
+			got := estimateUnlock(tt.debondEnd, schedule, 1180, now, 6*time.Second)
+			if got.EpochsLeft != tt.epochsLeft {
+				t.Errorf("unexpected epochs left, want %d; got %d", tt.epochsLeft, got.EpochsLeft)
+			}
+			if got.Height != tt.height {
+				t.Errorf("unexpected height, want %d; got %d", tt.height, got.Height)
+			}
+			if !got.Time.Equal(tt.time) {
+				t.Errorf("unexpected time, want %v; got %v", tt.time, got.Time)
+			}
+		})
+	}
+}
+
+func quantity(i int64) types.Quantity {
+	return types.NewQuantity(big.NewInt(i))
+}
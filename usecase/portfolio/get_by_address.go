@@ -0,0 +1,203 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	// blockTimeSampleSize is the number of recent blocks used to compute average block time
+	blockTimeSampleSize = 1000
+
+	rewardsPeriodDays = 30
+)
+
+type getByAddressUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewGetByAddressUseCase(cfg *config.Config, db *store.Store, c *client.Client) *getByAddressUseCase {
+	return &getByAddressUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+// Execute returns general balance, delegations, debonding delegations and rewards of address at the most recent indexed height.
+// Account and delegations are read from the node state at that height
+func (uc *getByAddressUseCase) Execute(address string) (*DetailsView, error) {
+	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
+	if err != nil {
+		return nil, err
+	}
+	height := mostRecentSynced.Height
+
+	rawAccount, err := uc.client.Account.GetByAddress(address, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delegations of address are keyed by escrow address
+	rawDelegations, err := uc.client.Delegation.GetByAddress(address, height)
+	if err != nil {
+		return nil, err
+	}
+	rawDebondingDelegations, err := uc.client.DebondingDelegation.GetByAddress(address, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var escrowAddresses []string
+	for escrowAddress := range rawDelegations.GetDelegations() {
+		escrowAddresses = append(escrowAddresses, escrowAddress)
+	}
+	for escrowAddress := range rawDebondingDelegations.GetDebondingDelegations() {
+		escrowAddresses = append(escrowAddresses, escrowAddress)
+	}
+
+	pools, err := uc.getPools(escrowAddresses)
+	if err != nil {
+		return nil, err
+	}
+	names, err := uc.getEntityNames(escrowAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsLifetime, err := uc.db.BalanceSummary.FindRewardsByEscrowForAddress(address, types.IntervalDaily, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	rewards30d, err := uc.db.BalanceSummary.FindRewardsByEscrowForAddress(address, types.IntervalDaily, mostRecentSynced.Time.AddDate(0, 0, -rewardsPeriodDays))
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := uc.getBlockTime()
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := uc.getEpochSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	view := ToDetailsView(address, mostRecentSynced, rawAccount.GetAccount())
+	view.Rewards = ToRewardsView(rewardsLifetime, rewards30d)
+
+	for escrowAddress, d := range rawDelegations.GetDelegations() {
+		pool := pools[escrowAddress]
+		shares := types.NewQuantityFromBytes(d.GetShares())
+		item := DelegationItem{
+			ValidatorUID: escrowAddress,
+			EntityName:   names[escrowAddress],
+			Shares:       shares,
+			Value:        shareValue(shares, pool.RecentEscrowActiveBalance, pool.RecentEscrowActiveTotalShares),
+
+			RewardsLifetime: rewardsOf(rewardsLifetime, escrowAddress),
+			Rewards30d:      rewardsOf(rewards30d, escrowAddress),
+		}
+
+		view.Delegations = append(view.Delegations, item)
+		view.TotalActive.Int.Add(&view.TotalActive.Int, &item.Value.Int)
+	}
+
+	for escrowAddress, entry := range rawDebondingDelegations.GetDebondingDelegations() {
+		pool := pools[escrowAddress]
+		for _, d := range entry.GetDebondingDelegations() {
+			shares := types.NewQuantityFromBytes(d.GetShares())
+			item := DebondingDelegationItem{
+				ValidatorUID: escrowAddress,
+				EntityName:   names[escrowAddress],
+				Shares:       shares,
+				Value:        shareValue(shares, pool.RecentEscrowDebondingBalance, pool.RecentEscrowDebondingTotalShares),
+				DebondEnd:    d.GetDebondEndTime(),
+			}
+			if schedule != nil {
+				unlock := estimateUnlock(item.DebondEnd, *schedule, height, mostRecentSynced.Time.Time, blockTime)
+				item.EstimatedEpochsLeft = &unlock.EpochsLeft
+				item.EstimatedUnlockHeight = &unlock.Height
+				item.EstimatedUnlockTime = &unlock.Time
+			}
+
+			view.DebondingDelegations = append(view.DebondingDelegations, item)
+			view.TotalDebonding.Int.Add(&view.TotalDebonding.Int, &item.Value.Int)
+		}
+	}
+
+	view.Total = view.GeneralBalance.Clone()
+	view.Total.Int.Add(&view.Total.Int, &view.TotalActive.Int)
+	view.Total.Int.Add(&view.Total.Int, &view.TotalDebonding.Int)
+
+	return view, nil
+}
+
+// getPools returns account aggregates with recent escrow pool balances and total shares of given escrow accounts
+func (uc *getByAddressUseCase) getPools(addresses []string) (map[string]model.AccountAgg, error) {
+	pools := map[string]model.AccountAgg{}
+	if len(addresses) == 0 {
+		return pools, nil
+	}
+
+	accounts, err := uc.db.AccountAgg.FindByPublicKeys(addresses)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range accounts {
+		pools[a.PublicKey] = a
+	}
+	return pools, nil
+}
+
+// getEntityNames returns entity names of validators with given addresses
+func (uc *getByAddressUseCase) getEntityNames(addresses []string) (map[string]string, error) {
+	names := map[string]string{}
+	if len(addresses) == 0 {
+		return names, nil
+	}
+
+	validators, err := uc.db.ValidatorAgg.FindByAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range validators {
+		names[v.Address] = v.EntityName
+	}
+	return names, nil
+}
+
+// getEpochSchedule returns epoch schedule of the network starting at genesis height reported by the node.
+// Schedule is nil when epoch interval is not configured
+func (uc *getByAddressUseCase) getEpochSchedule() (*epochSchedule, error) {
+	if uc.cfg.EpochInterval <= 0 {
+		return nil, nil
+	}
+
+	status, err := uc.client.Chain.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &epochSchedule{
+		GenesisHeight: status.GetGenesisHeight(),
+		Base:          uc.cfg.EpochBase,
+		Interval:      uc.cfg.EpochInterval,
+	}, nil
+}
+
+// getBlockTime returns average time of recent blocks
+func (uc *getByAddressUseCase) getBlockTime() (time.Duration, error) {
+	res, err := uc.db.BlockSeq.GetAvgRecentTimes(blockTimeSampleSize)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(res.Avg * float64(time.Second)), nil
+}
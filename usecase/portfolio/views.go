@@ -0,0 +1,98 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type DetailsView struct {
+	Address string     `json:"address"`
+	Height  int64      `json:"height"`
+	Time    types.Time `json:"time"`
+
+	GeneralBalance types.Quantity `json:"general_balance"`
+	GeneralNonce   uint64         `json:"general_nonce"`
+	TotalActive    types.Quantity `json:"total_active"`
+	TotalDebonding types.Quantity `json:"total_debonding"`
+	Total          types.Quantity `json:"total"`
+
+	Delegations          []DelegationItem          `json:"delegations"`
+	DebondingDelegations []DebondingDelegationItem `json:"debonding_delegations"`
+	Rewards              RewardsView               `json:"rewards"`
+}
+
+type DelegationItem struct {
+	ValidatorUID    string         `json:"validator_uid"`
+	EntityName      string         `json:"entity_name"`
+	Shares          types.Quantity `json:"shares"`
+	Value           types.Quantity `json:"value"`
+	RewardsLifetime types.Quantity `json:"rewards_lifetime"`
+	Rewards30d      types.Quantity `json:"rewards_30d"`
+}
+
+type DebondingDelegationItem struct {
+	ValidatorUID          string         `json:"validator_uid"`
+	EntityName            string         `json:"entity_name"`
+	Shares                types.Quantity `json:"shares"`
+	Value                 types.Quantity `json:"value"`
+	DebondEnd             uint64         `json:"debond_end"`
+	EstimatedEpochsLeft   *int64         `json:"estimated_epochs_left"`
+	EstimatedUnlockHeight *int64         `json:"estimated_unlock_height"`
+	EstimatedUnlockTime   *time.Time     `json:"estimated_unlock_time"`
+}
+
+type RewardsView struct {
+	Lifetime           types.Quantity `json:"lifetime"`
+	Last30d            types.Quantity `json:"last_30d"`
+	CommissionLifetime types.Quantity `json:"commission_lifetime"`
+	Commission30d      types.Quantity `json:"commission_30d"`
+}
+
+func ToDetailsView(address string, syncable *model.Syncable, rawAccount *accountpb.Account) *DetailsView {
+	return &DetailsView{
+		Address: address,
+		Height:  syncable.Height,
+		Time:    syncable.Time,
+
+		GeneralBalance: types.NewQuantityFromBytes(rawAccount.GetGeneral().GetBalance()),
+		GeneralNonce:   rawAccount.GetGeneral().GetNonce(),
+		TotalActive:    types.NewQuantityFromInt64(0),
+		TotalDebonding: types.NewQuantityFromInt64(0),
+
+		Delegations:          []DelegationItem{},
+		DebondingDelegations: []DebondingDelegationItem{},
+	}
+}
+
+func ToRewardsView(lifetime []store.EscrowRewardsRow, last30d []store.EscrowRewardsRow) RewardsView {
+	view := RewardsView{
+		Lifetime:           types.NewQuantityFromInt64(0),
+		Last30d:            types.NewQuantityFromInt64(0),
+		CommissionLifetime: types.NewQuantityFromInt64(0),
+		Commission30d:      types.NewQuantityFromInt64(0),
+	}
+
+	for _, row := range lifetime {
+		view.Lifetime.Int.Add(&view.Lifetime.Int, &row.TotalRewards.Int)
+		view.CommissionLifetime.Int.Add(&view.CommissionLifetime.Int, &row.TotalCommission.Int)
+	}
+	for _, row := range last30d {
+		view.Last30d.Int.Add(&view.Last30d.Int, &row.TotalRewards.Int)
+		view.Commission30d.Int.Add(&view.Commission30d.Int, &row.TotalCommission.Int)
+	}
+	return view
+}
+
+// rewardsOf returns rewards received from escrow account
+func rewardsOf(rows []store.EscrowRewardsRow, escrowAddress string) types.Quantity {
+	for _, row := range rows {
+		if row.EscrowAddress == escrowAddress {
+			return row.TotalRewards
+		}
+	}
+	return types.NewQuantityFromInt64(0)
+}
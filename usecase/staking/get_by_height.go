@@ -3,7 +3,6 @@ package staking
 import (
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
-	"github.com/pkg/errors"
 )
 
 type getByHeightUseCase struct {
@@ -32,7 +31,7 @@ func (uc *getByHeightUseCase) Execute(height *int64) (*DetailsView, error) {
 	}
 
 	if *height > lastH {
-		return nil, errors.New("height is not indexed yet")
+		return nil, store.ErrHeightNotIndexed
 	}
 
 	res, err := uc.client.State.GetStakingByHeight(*height)
@@ -0,0 +1,59 @@
+package stream
+
+import (
+	"sync"
+)
+
+const (
+	subscriberBufferSize = 100
+)
+
+// Broker fans out processed heights to subscribers
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan int64]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: map[chan int64]struct{}{},
+	}
+}
+
+// Subscribe returns channel receiving processed heights.
+// Channel is closed when subscriber falls too far behind or is unsubscribed
+func (b *Broker) Subscribe() chan int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan int64, subscriberBufferSize)
+	b.subscribers[ch] = struct{}{}
+	return ch
+}
+
+// Unsubscribe removes subscriber and closes its channel
+func (b *Broker) Unsubscribe(ch chan int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
+
+// Publish notifies all subscribers about processed height without blocking
+func (b *Broker) Publish(height int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- height:
+		default:
+			// Slow subscriber is dropped, client resumes from the last received height
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
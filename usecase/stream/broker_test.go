@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"testing"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	t.Run("delivers heights to all subscribers", func(t *testing.T) {
+		broker := NewBroker()
+		first := broker.Subscribe()
+		second := broker.Subscribe()
+
+		broker.Publish(10)
+
+		for _, ch := range []chan int64{first, second} {
+			if height := <-ch; height != 10 {
+				t.Errorf("unexpected height, want: %d, got: %d", 10, height)
+			}
+		}
+	})
+
+	t.Run("drops subscriber with full buffer", func(t *testing.T) {
+		broker := NewBroker()
+		ch := broker.Subscribe()
+
+		for i := int64(0); i <= subscriberBufferSize; i++ {
+			broker.Publish(i)
+		}
+
+		var count int
+		for range ch {
+			count++
+		}
+		if count != subscriberBufferSize {
+			t.Errorf("unexpected number of heights, want: %d, got: %d", subscriberBufferSize, count)
+		}
+
+		// Unsubscribing dropped subscriber must not close channel again
+		broker.Unsubscribe(ch)
+	})
+}
@@ -0,0 +1,57 @@
+package stream
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	// processedChannel is notified by syncable_processed trigger with height of processed syncable
+	processedChannel = "syncable_processed"
+
+	listenerMinReconnectInterval = 10 * time.Second
+	listenerMaxReconnectInterval = time.Minute
+	listenerPingInterval         = 90 * time.Second
+)
+
+// listen publishes heights marked as processed by the worker to the broker
+func listen(dsn string, broker *Broker) error {
+	listener := pq.NewListener(dsn, listenerMinReconnectInterval, listenerMaxReconnectInterval, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error(errors.Wrap(err, "stream listener error"))
+		}
+	})
+
+	if err := listener.Listen(processedChannel); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case n := <-listener.Notify:
+				// nil notification is sent after reconnect, heights processed in between are replayed by subscribers
+				if n == nil {
+					continue
+				}
+
+				height, err := strconv.ParseInt(n.Extra, 10, 64)
+				if err != nil {
+					logger.Error(errors.Wrap(err, fmt.Sprintf("invalid %s notification %q", processedChannel, n.Extra)))
+					continue
+				}
+				broker.Publish(height)
+			case <-time.After(listenerPingInterval):
+				go listener.Ping()
+			}
+		}
+	}()
+
+	return nil
+}
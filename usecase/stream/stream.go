@@ -0,0 +1,170 @@
+package stream
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+const (
+	TopicBlocks             = "blocks"
+	TopicValidatorSequences = "validator_sequences"
+	TopicSystemEvents       = "system_events"
+
+	replayBatchSize = 1000
+	// maxReplayHeights limits how far behind the most recent height clients can start replay from
+	maxReplayHeights = 10000
+)
+
+var (
+	ErrInvalidTopic = errors.New("invalid topic")
+	ErrReplayTooOld = errors.New("from_height is older than replay window")
+
+	topics = []string{TopicBlocks, TopicValidatorSequences, TopicSystemEvents}
+)
+
+// Query selects records sent to the stream
+type Query struct {
+	Topics []string
+	// Actor limits validator sequences and system events to given address
+	Actor string
+}
+
+// ParseTopics parses comma separated list of topics. Empty list selects all topics
+func ParseTopics(s string) ([]string, error) {
+	if s == "" {
+		return topics, nil
+	}
+
+	var result []string
+	for _, topic := range strings.Split(s, ",") {
+		topic = strings.TrimSpace(topic)
+		if !isValidTopic(topic) {
+			return nil, ErrInvalidTopic
+		}
+		result = append(result, topic)
+	}
+	return result, nil
+}
+
+func isValidTopic(topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a single record sent to the stream
+type Event struct {
+	Height int64
+	Topic  string
+	Data   interface{}
+}
+
+type streamUseCase struct {
+	db *store.Store
+}
+
+func NewStreamUseCase(db *store.Store) *streamUseCase {
+	return &streamUseCase{
+		db: db,
+	}
+}
+
+// ReplayEnd returns the most recent height replay from given height runs to.
+// It returns ErrReplayTooOld when from height is more than maxReplayHeights behind the most recent height
+func (uc *streamUseCase) ReplayEnd(from int64) (int64, error) {
+	syncable, err := uc.db.Syncables.FindMostRecent()
+	if err == store.ErrNotFound {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	if from < syncable.Height-maxReplayHeights {
+		return 0, ErrReplayTooOld
+	}
+	return syncable.Height, nil
+}
+
+// Replay calls fn with records of all heights processed in range [from, to] in ascending order.
+// It returns the height replay should continue from
+func (uc *streamUseCase) Replay(q Query, from int64, to int64, fn func(Event) error) (int64, error) {
+	next := from
+	for {
+		heights, err := uc.db.Syncables.FindProcessedHeights(next, to, replayBatchSize)
+		if err != nil {
+			return next, err
+		}
+
+		for _, height := range heights {
+			if err := uc.send(q, height, fn); err != nil {
+				return next, err
+			}
+			next = height + 1
+		}
+
+		if len(heights) < replayBatchSize {
+			return next, nil
+		}
+	}
+}
+
+func (uc *streamUseCase) send(q Query, height int64, fn func(Event) error) error {
+	for _, topic := range q.Topics {
+		events, err := uc.getEvents(q, topic, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (uc *streamUseCase) getEvents(q Query, topic string, height int64) ([]Event, error) {
+	var events []Event
+
+	switch topic {
+	case TopicBlocks:
+		block, err := uc.db.BlockSeq.FindByHeight(height)
+		if err != nil {
+			if err == store.ErrNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		events = append(events, Event{Height: height, Topic: topic, Data: block})
+	case TopicValidatorSequences:
+		validators, err := uc.db.ValidatorSeq.FindByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, validator := range validators {
+			if q.Actor != "" && validator.Address != q.Actor {
+				continue
+			}
+			events = append(events, Event{Height: height, Topic: topic, Data: validator})
+		}
+	case TopicSystemEvents:
+		systemEvents, err := uc.db.SystemEvents.FindByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, systemEvent := range systemEvents {
+			if q.Actor != "" && systemEvent.Actor != q.Actor {
+				continue
+			}
+			events = append(events, Event{Height: height, Topic: topic, Data: systemEvent})
+		}
+	}
+
+	return events, nil
+}
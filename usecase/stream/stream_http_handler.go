@@ -0,0 +1,198 @@
+package stream
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	pingInterval = 30 * time.Second
+)
+
+var (
+	_ types.HttpHandler = (*streamHttpHandler)(nil)
+
+	ErrInvalidFromHeight = errors.New("invalid from_height")
+)
+
+type streamHttpHandler struct {
+	cfg *config.Config
+	db  *store.Store
+
+	mu      sync.Mutex
+	broker  *Broker
+	useCase *streamUseCase
+}
+
+func NewStreamHttpHandler(cfg *config.Config, db *store.Store) *streamHttpHandler {
+	return &streamHttpHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+type Request struct {
+	Topics     string `form:"topics" binding:"-"`
+	Actor      string `form:"actor" binding:"-"`
+	FromHeight *int64 `form:"from_height" binding:"-"`
+}
+
+func (h *streamHttpHandler) Handle(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, ErrInvalidFromHeight)
+		return
+	}
+
+	topics, err := ParseTopics(req.Topics)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+	q := Query{
+		Topics: topics,
+		Actor:  req.Actor,
+	}
+
+	// Reconnecting clients resume after the last received height
+	var next int64
+	resume := true
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		lastHeight, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			http.BadRequest(c, errors.New("invalid Last-Event-ID"))
+			return
+		}
+		next = lastHeight + 1
+	} else if req.FromHeight != nil {
+		if *req.FromHeight < 0 {
+			http.BadRequest(c, ErrInvalidFromHeight)
+			return
+		}
+		next = *req.FromHeight
+	} else {
+		resume = false
+	}
+
+	broker, err := h.getBroker()
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	// Subscribe before replay, so heights processed during replay are not missed
+	heights := broker.Subscribe()
+	defer broker.Unsubscribe(heights)
+
+	uc := h.getUseCase()
+	var replayTo int64
+	if resume {
+		replayTo, err = uc.ReplayEnd(next)
+		if err == ErrReplayTooOld {
+			http.BadRequest(c, err)
+			return
+		} else if http.ShouldReturn(c, err) {
+			return
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(200)
+	c.Writer.Flush()
+
+	send := func(event Event) error {
+		// Stop replay once client is gone
+		if err := c.Request.Context().Err(); err != nil {
+			return err
+		}
+
+		c.Render(-1, sse.Event{
+			Id:    strconv.FormatInt(event.Height, 10),
+			Event: event.Topic,
+			Data:  event.Data,
+		})
+		c.Writer.Flush()
+		return nil
+	}
+
+	if resume {
+		if next, err = uc.Replay(q, next, replayTo, send); err != nil {
+			h.sendError(c, err)
+			return
+		}
+	}
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case height, ok := <-heights:
+			if !ok {
+				// Subscriber was dropped, client has to reconnect using Last-Event-ID
+				return
+			}
+			if !resume {
+				next, resume = height, true
+			}
+			if height < next {
+				continue
+			}
+			if next, err = uc.Replay(q, next, height, send); err != nil {
+				h.sendError(c, err)
+				return
+			}
+		case <-ticker.C:
+			c.Render(-1, sse.Event{Event: "ping", Data: time.Now().Unix()})
+			c.Writer.Flush()
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+func (h *streamHttpHandler) sendError(c *gin.Context, err error) {
+	if c.Request.Context().Err() != nil {
+		// Client is gone, there is nobody to report error to
+		return
+	}
+
+	logger.Error(err)
+	c.Render(-1, sse.Event{Event: "error", Data: err.Error()})
+	c.Writer.Flush()
+}
+
+// getBroker starts listening for processed heights on first use.
+// Failed attempt is retried with the next request
+func (h *streamHttpHandler) getBroker() (*Broker, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.broker == nil {
+		broker := NewBroker()
+		if err := listen(h.cfg.DatabaseDSN, broker); err != nil {
+			return nil, err
+		}
+		h.broker = broker
+	}
+	return h.broker, nil
+}
+
+func (h *streamHttpHandler) getUseCase() *streamUseCase {
+	if h.useCase == nil {
+		h.useCase = NewStreamUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,149 @@
+package stream
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestStreamUseCase_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncablesStore := mock.NewMockSyncablesStore(ctrl)
+	blockSeqStore := mock.NewMockBlockSeqStore(ctrl)
+	systemEventsStore := mock.NewMockSystemEventsStore(ctrl)
+
+	syncablesStore.EXPECT().FindProcessedHeights(int64(10), int64(20), int64(replayBatchSize)).Return([]int64{10, 12}, nil)
+	blockSeqStore.EXPECT().FindByHeight(int64(10)).Return(&model.BlockSeq{}, nil)
+	blockSeqStore.EXPECT().FindByHeight(int64(12)).Return(nil, store.ErrNotFound)
+	systemEventsStore.EXPECT().FindByHeight(int64(10)).Return(nil, nil)
+	systemEventsStore.EXPECT().FindByHeight(int64(12)).Return([]model.SystemEvent{{Actor: "other"}, {Actor: "actor"}}, nil)
+
+	uc := NewStreamUseCase(&store.Store{
+		Syncables:    syncablesStore,
+		BlockSeq:     blockSeqStore,
+		SystemEvents: systemEventsStore,
+	})
+
+	var events []Event
+	q := Query{Topics: []string{TopicBlocks, TopicSystemEvents}, Actor: "actor"}
+	next, err := uc.Replay(q, 10, 20, func(event Event) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if next != 13 {
+		t.Errorf("unexpected next height, want: %d, got: %d", 13, next)
+	}
+
+	expected := []Event{{Height: 10, Topic: TopicBlocks}, {Height: 12, Topic: TopicSystemEvents}}
+	if len(events) != len(expected) {
+		t.Fatalf("unexpected number of events, want: %d, got: %d", len(expected), len(events))
+	}
+	for i, event := range events {
+		if event.Height != expected[i].Height || event.Topic != expected[i].Topic {
+			t.Errorf("unexpected event %d, want: %d %s, got: %d %s", i, expected[i].Height, expected[i].Topic, event.Height, event.Topic)
+		}
+	}
+	if event := events[1].Data.(model.SystemEvent); event.Actor != "actor" {
+		t.Errorf("unexpected actor, want: %s, got: %s", "actor", event.Actor)
+	}
+}
+
+func TestStreamUseCase_Replay_stopsOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncablesStore := mock.NewMockSyncablesStore(ctrl)
+	blockSeqStore := mock.NewMockBlockSeqStore(ctrl)
+
+	syncablesStore.EXPECT().FindProcessedHeights(int64(10), int64(20), int64(replayBatchSize)).Return([]int64{10, 11, 12}, nil)
+	blockSeqStore.EXPECT().FindByHeight(int64(10)).Return(&model.BlockSeq{}, nil)
+
+	uc := NewStreamUseCase(&store.Store{
+		Syncables: syncablesStore,
+		BlockSeq:  blockSeqStore,
+	})
+
+	q := Query{Topics: []string{TopicBlocks}}
+	next, err := uc.Replay(q, 10, 20, func(event Event) error {
+		return context.Canceled
+	})
+	if err != context.Canceled {
+		t.Errorf("unexpected error, want: %v, got: %v", context.Canceled, err)
+	}
+	if next != 10 {
+		t.Errorf("unexpected next height, want: %d, got: %d", 10, next)
+	}
+}
+
+func TestParseTopics(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    []string
+		expectErr   error
+	}{
+		{description: "returns all topics when empty", input: "", expected: topics},
+		{description: "parses list", input: "blocks, system_events", expected: []string{TopicBlocks, TopicSystemEvents}},
+		{description: "returns error for unknown topic", input: "blocks,accounts", expectErr: ErrInvalidTopic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result, err := ParseTopics(tt.input)
+			if err != tt.expectErr {
+				t.Fatalf("unexpected error, want: %v, got: %v", tt.expectErr, err)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("unexpected topics, want: %v, got: %v", tt.expected, result)
+			}
+			for i := range result {
+				if result[i] != tt.expected[i] {
+					t.Errorf("unexpected topic, want: %s, got: %s", tt.expected[i], result[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamUseCase_ReplayEnd(t *testing.T) {
+	tests := []struct {
+		description string
+		from        int64
+		expectedTo  int64
+		expectedErr error
+	}{
+		{"returns most recent height", 20000, 25000, nil},
+		{"accepts start of replay window", 15000, 25000, nil},
+		{"rejects height older than replay window", 14999, 0, ErrReplayTooOld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncablesStore := mock.NewMockSyncablesStore(ctrl)
+			syncablesStore.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 25000}, nil)
+
+			uc := NewStreamUseCase(&store.Store{Syncables: syncablesStore})
+
+			to, err := uc.ReplayEnd(tt.from)
+			if err != tt.expectedErr {
+				t.Fatalf("unexpected error, want: %v, got: %v", tt.expectedErr, err)
+			}
+			if to != tt.expectedTo {
+				t.Errorf("unexpected replay end, want: %d, got: %d", tt.expectedTo, to)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package systemevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type acknowledgeUseCase struct {
+	db *store.Store
+}
+
+func NewAcknowledgeUseCase(db *store.Store) *acknowledgeUseCase {
+	return &acknowledgeUseCase{
+		db: db,
+	}
+}
+
+func (uc *acknowledgeUseCase) Execute(id types.ID, subscriber string, note *string) (*model.SystemEventAck, error) {
+	systemEvent, err := uc.db.SystemEvents.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	ack := &model.SystemEventAck{
+		SystemEventID: systemEvent.ID,
+		Subscriber:    subscriber,
+		Note:          note,
+	}
+	if err := uc.db.SystemEventAcks.CreateOrUpdate(ack); err != nil {
+		return nil, err
+	}
+	return ack, nil
+}
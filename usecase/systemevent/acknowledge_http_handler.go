@@ -0,0 +1,74 @@
+package systemevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*acknowledgeHttpHandler)(nil)
+)
+
+type acknowledgeHttpHandler struct {
+	db *store.Store
+
+	useCase *acknowledgeUseCase
+}
+
+func NewAcknowledgeHttpHandler(db *store.Store) *acknowledgeHttpHandler {
+	return &acknowledgeHttpHandler{
+		db: db,
+	}
+}
+
+type SystemEventRequest struct {
+	ID types.ID `uri:"id" binding:"required"`
+}
+
+// AcknowledgeRequest is the body of acknowledge request.
+// Subscriber defaults to name of api key request was authenticated with
+type AcknowledgeRequest struct {
+	Subscriber string  `json:"subscriber" binding:"-"`
+	Note       *string `json:"note" binding:"-"`
+}
+
+func (h *acknowledgeHttpHandler) Handle(c *gin.Context) {
+	var uriReq SystemEventRequest
+	if err := c.ShouldBindUri(&uriReq); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	var req AcknowledgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid acknowledgement"))
+		return
+	}
+
+	subscriber, err := http.Subscriber(c, req.Subscriber)
+	if err == http.ErrSubscriberNotAllowed {
+		http.Forbidden(c, err)
+		return
+	}
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(uriReq.ID, subscriber, req.Note)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *acknowledgeHttpHandler) getUseCase() *acknowledgeUseCase {
+	if h.useCase == nil {
+		h.useCase = NewAcknowledgeUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,76 @@
+package systemevent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	apihttp "github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAcknowledgeHttpHandler_Handle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		description        string
+		path               string
+		body               string
+		apiKey             *model.APIKey
+		expectedStatus     int
+		expectedSubscriber string
+	}{
+		{"acknowledges system event for subscriber of anonymous request", "/system_events/10/ack", `{"subscriber":"alice","note":"seen"}`, nil, http.StatusOK, "alice"},
+		{"acknowledges system event for api key", "/system_events/10/ack", `{}`, &model.APIKey{Name: "bob"}, http.StatusOK, "bob"},
+		{"accepts subscriber matching api key", "/system_events/10/ack", `{"subscriber":"bob"}`, &model.APIKey{Name: "bob"}, http.StatusOK, "bob"},
+		{"rejects subscriber of other api key", "/system_events/10/ack", `{"subscriber":"alice"}`, &model.APIKey{Name: "bob"}, http.StatusForbidden, ""},
+		{"rejects anonymous request without subscriber", "/system_events/10/ack", `{}`, nil, http.StatusBadRequest, ""},
+		{"rejects invalid id", "/system_events/abc/ack", `{"subscriber":"alice"}`, nil, http.StatusBadRequest, ""},
+		{"rejects invalid body", "/system_events/10/ack", `not json`, nil, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			systemEventsStore := mock_store.NewMockSystemEventsStore(ctrl)
+			acksStore := mock_store.NewMockSystemEventAcksStore(ctrl)
+
+			if tt.expectedStatus == http.StatusOK {
+				systemEventsStore.EXPECT().FindByID(types.ID(10)).Return(&model.SystemEvent{Model: &model.Model{ID: types.ID(10)}}, nil).Times(1)
+				acksStore.EXPECT().CreateOrUpdate(gomock.Any()).DoAndReturn(func(ack *model.SystemEventAck) error {
+					if ack.SystemEventID != types.ID(10) {
+						t.Errorf("unexpected system event id, want %d; got %d", 10, ack.SystemEventID)
+					}
+					if ack.Subscriber != tt.expectedSubscriber {
+						t.Errorf("unexpected subscriber, want %s; got %s", tt.expectedSubscriber, ack.Subscriber)
+					}
+					return nil
+				}).Times(1)
+			}
+
+			h := NewAcknowledgeHttpHandler(&store.Store{SystemEvents: systemEventsStore, SystemEventAcks: acksStore})
+
+			engine := gin.New()
+			engine.POST("/system_events/:id/ack", func(c *gin.Context) {
+				if tt.apiKey != nil {
+					apihttp.SetAPIKey(c, tt.apiKey)
+				}
+			}, h.Handle)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("unexpected status, want %d; got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
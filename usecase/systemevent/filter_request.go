@@ -0,0 +1,33 @@
+package systemevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSubscriberRequired = errors.New("subscriber is required to exclude acknowledged or muted system events")
+)
+
+// FilterRequest hides system events already handled by subscriber
+type FilterRequest struct {
+	Subscriber          string `form:"subscriber" binding:"-"`
+	ExcludeAcknowledged bool   `form:"exclude_acknowledged" binding:"-"`
+	ExcludeMuted        bool   `form:"exclude_muted" binding:"-"`
+}
+
+// ToQuery returns store query for system events after height of given kind
+func (r FilterRequest) ToQuery(after *int64, kind *model.SystemEventKind) (*store.FindSystemEventByActorQuery, error) {
+	if (r.ExcludeAcknowledged || r.ExcludeMuted) && r.Subscriber == "" {
+		return nil, ErrSubscriberRequired
+	}
+
+	return &store.FindSystemEventByActorQuery{
+		Kind:                kind,
+		MinHeight:           after,
+		Subscriber:          r.Subscriber,
+		ExcludeAcknowledged: r.ExcludeAcknowledged,
+		ExcludeMuted:        r.ExcludeMuted,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package systemevent
+
+import (
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+)
+
+func TestFilterRequest_ToQuery(t *testing.T) {
+	after := int64(10)
+	kind := model.SystemEventMissedNofM
+
+	tests := []struct {
+		description string
+		req         FilterRequest
+		expectedErr error
+	}{
+		{"returns query without subscriber", FilterRequest{}, nil},
+		{"returns query excluding acknowledged system events of subscriber", FilterRequest{Subscriber: "ops", ExcludeAcknowledged: true}, nil},
+		{"returns query excluding muted system events of subscriber", FilterRequest{Subscriber: "ops", ExcludeMuted: true}, nil},
+		{"returns error when acknowledged system events are excluded without subscriber", FilterRequest{ExcludeAcknowledged: true}, ErrSubscriberRequired},
+		{"returns error when muted system events are excluded without subscriber", FilterRequest{ExcludeMuted: true}, ErrSubscriberRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			query, err := tt.req.ToQuery(&after, &kind)
+			if err != tt.expectedErr {
+				t.Fatalf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if *query.MinHeight != after || *query.Kind != kind {
+				t.Errorf("unexpected query, want after %v and kind %v; got %v and %v", after, kind, *query.MinHeight, *query.Kind)
+			}
+			if query.Subscriber != tt.req.Subscriber || query.ExcludeAcknowledged != tt.req.ExcludeAcknowledged || query.ExcludeMuted != tt.req.ExcludeMuted {
+				t.Errorf("unexpected subscriber filter, want %+v; got %+v", tt.req, query)
+			}
+		})
+	}
+}
@@ -1,8 +1,8 @@
 package systemevent
 
 import (
-	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type getForAddressUseCase struct {
@@ -15,14 +15,17 @@ func NewGetForAddressUseCase(db *store.Store) *getForAddressUseCase {
 	}
 }
 
-func (uc *getForAddressUseCase) Execute(address string, minHeight *int64, kind *model.SystemEventKind) (*ListView, error) {
-	systemEvents, err := uc.db.SystemEvents.FindByActor(address, store.FindSystemEventByActorQuery{
-		Kind:      kind,
-		MinHeight: minHeight,
-	})
+func (uc *getForAddressUseCase) Execute(address string, query store.FindSystemEventByActorQuery, page store.Pagination) (*ListView, error) {
+	systemEvents, err := uc.db.SystemEvents.FindByActor(address, query, page)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToListView(systemEvents), nil
+	var nextCursor *store.Cursor
+	if len(systemEvents) > 0 {
+		last := systemEvents[len(systemEvents)-1]
+		nextCursor = page.NextCursor(len(systemEvents), last.Height, last.ID)
+	}
+
+	return ToListView(systemEvents, http.EncodeCursor(nextCursor)), nil
 }
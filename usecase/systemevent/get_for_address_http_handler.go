@@ -32,6 +32,9 @@ type GetForAddressRequest struct {
 	Address string                 `uri:"address" binding:"required"`
 	After   *int64                 `form:"after" binding:"-"`
 	Kind    *model.SystemEventKind `form:"kind" binding:"-"`
+
+	FilterRequest
+	http.PaginationRequest
 }
 
 func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
@@ -45,7 +48,19 @@ func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, req.After, req.Kind)
+	query, err := req.ToQuery(req.After, req.Kind)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	page, err := req.ToPagination()
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, *query, *page)
 	if http.ShouldReturn(c, err) {
 		return
 	}
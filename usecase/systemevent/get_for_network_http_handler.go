@@ -0,0 +1,71 @@
+package systemevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getForNetworkHttpHandler)(nil)
+)
+
+type getForNetworkHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getForAddressUseCase
+}
+
+func NewGetForNetworkHttpHandler(db *store.Store, c *client.Client) *getForNetworkHttpHandler {
+	return &getForNetworkHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetForNetworkRequest struct {
+	After *int64                 `form:"after" binding:"-"`
+	Kind  *model.SystemEventKind `form:"kind" binding:"-"`
+
+	FilterRequest
+	http.PaginationRequest
+}
+
+func (h *getForNetworkHttpHandler) Handle(c *gin.Context) {
+	var req GetForNetworkRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid kind or/and after"))
+		return
+	}
+
+	query, err := req.ToQuery(req.After, req.Kind)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	page, err := req.ToPagination()
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(model.SystemEventActorNetwork, *query, *page)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getForNetworkHttpHandler) getUseCase() *getForAddressUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetForAddressUseCase(h.db)
+	}
+	return h.useCase
+}
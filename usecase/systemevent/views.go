@@ -16,13 +16,23 @@ type ListItem struct {
 }
 
 type ListView struct {
-	Items []ListItem `json:"items"`
+	Items      []ListItem `json:"items"`
+	NextCursor *string    `json:"next_cursor"`
 }
 
-func ToListView(validators []model.SystemEvent) *ListView {
+func (v ListView) PageItems() interface{} {
+	return v.Items
+}
+
+func (v ListView) PageNextCursor() *string {
+	return v.NextCursor
+}
+
+func ToListView(validators []model.SystemEvent, nextCursor *string) *ListView {
 	var items []ListItem
 	for _, m := range validators {
 		item := ListItem{
+			Model:  m.Model,
 			Actor:  m.Actor,
 			Height: m.Height,
 			Time:   m.Time,
@@ -34,6 +44,7 @@ func ToListView(validators []model.SystemEvent) *ListView {
 	}
 
 	return &ListView{
-		Items: items,
+		Items:      items,
+		NextCursor: nextCursor,
 	}
 }
@@ -3,6 +3,7 @@ package validator
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type getByAddressUseCase struct {
@@ -15,29 +16,34 @@ func NewGetByAddressUseCase(db *store.Store) *getByAddressUseCase {
 	}
 }
 
-func (uc *getByAddressUseCase) Execute(key string, sequencesLimit int64) (*AggDetailsView, error) {
+func (uc *getByAddressUseCase) Execute(key string, sequencesPage store.Pagination) (*AggDetailsView, error) {
 	validatorAggs, err := uc.db.ValidatorAgg.FindByAddress(key)
 	if err != nil {
 		return nil, err
 	}
 
-	sequences, err := uc.getSequences(key, sequencesLimit)
+	sequences, err := uc.getSequences(key, sequencesPage)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToAggDetailsView(validatorAggs, sequences), nil
+	var nextCursor *store.Cursor
+	if len(sequences) > 0 {
+		last := sequences[len(sequences)-1]
+		nextCursor = sequencesPage.NextCursor(len(sequences), last.Height, last.ID)
+	}
+
+	return ToAggDetailsView(validatorAggs, sequences, http.EncodeCursor(nextCursor)), nil
 }
 
-func (uc *getByAddressUseCase) getSequences(address string, sequencesLimit int64) ([]model.ValidatorSeq, error) {
+func (uc *getByAddressUseCase) getSequences(address string, sequencesPage store.Pagination) ([]model.ValidatorSeq, error) {
 	var sequences []model.ValidatorSeq
 	var err error
-	if sequencesLimit > 0 {
-		sequences, err = uc.db.ValidatorSeq.FindLastByAddress(address, sequencesLimit)
+	if sequencesPage.Limit > 0 {
+		sequences, err = uc.db.ValidatorSeq.FindLastByAddress(address, sequencesPage)
 		if err != nil {
 			return nil, err
 		}
 	}
 	return sequences, nil
 }
-
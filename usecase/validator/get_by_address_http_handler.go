@@ -28,8 +28,9 @@ func NewGetByAddressHttpHandler(db *store.Store, c *client.Client) *getByAddress
 }
 
 type GetByEntityUidRequest struct {
-	Address        string `uri:"address" binding:"required"`
-	SequencesLimit int64  `form:"sequences_limit" binding:"-"`
+	Address         string `uri:"address" binding:"required"`
+	SequencesLimit  int64  `form:"sequences_limit" binding:"-"`
+	SequencesCursor string `form:"sequences_cursor" binding:"-"`
 }
 
 func (h *getByAddressHttpHandler) Handle(c *gin.Context) {
@@ -43,7 +44,22 @@ func (h *getByAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, req.SequencesLimit)
+	if req.SequencesLimit < 0 || req.SequencesLimit > http.MaxPageLimit {
+		http.BadRequest(c, errors.New("invalid sequences limit"))
+		return
+	}
+
+	sequencesPage := store.Pagination{Limit: req.SequencesLimit}
+	if req.SequencesCursor != "" {
+		cursor, err := store.DecodeCursor(req.SequencesCursor)
+		if err != nil {
+			http.BadRequest(c, err)
+			return
+		}
+		sequencesPage.Cursor = cursor
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, sequencesPage)
 	if http.ShouldReturn(c, err) {
 		return
 	}
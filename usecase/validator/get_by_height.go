@@ -7,7 +7,6 @@ import (
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/indexer"
 	"github.com/figment-networks/oasishub-indexer/store"
-	"github.com/pkg/errors"
 )
 
 type getByHeightUseCase struct {
@@ -38,10 +37,11 @@ func (uc *getByHeightUseCase) Execute(height *int64) (SeqListView, error) {
 	}
 
 	if *height > lastH {
-		return SeqListView{}, errors.New("height is not indexed yet")
+		return SeqListView{}, store.ErrHeightNotIndexed
 	}
 
-	aggs, err := uc.db.ValidatorAgg.GetAllForHeightGreaterThan(*height)
+	// All aggregates are needed to decorate sequences at height
+	aggs, err := uc.db.ValidatorAgg.GetAllForHeightGreaterThan(*height, store.Pagination{})
 	if err != nil {
 		return SeqListView{}, err
 	}
@@ -2,7 +2,7 @@ package validator
 
 import (
 	"github.com/figment-networks/oasishub-indexer/store"
-	"github.com/pkg/errors"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type getForMinHeightUseCase struct {
@@ -15,7 +15,7 @@ func NewGetForMinHeightUseCase(db *store.Store) *getForMinHeightUseCase {
 	}
 }
 
-func (uc *getForMinHeightUseCase) Execute(height *int64) (*AggListView, error) {
+func (uc *getForMinHeightUseCase) Execute(height *int64, page store.Pagination) (*AggListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -29,15 +29,21 @@ func (uc *getForMinHeightUseCase) Execute(height *int64) (*AggListView, error) {
 	}
 
 	if *height > lastH {
-		return nil, errors.New("height is not indexed yet")
+		return nil, store.ErrHeightNotIndexed
 	}
 
-	ms, err := uc.db.ValidatorAgg.GetAllForHeightGreaterThan(*height)
+	ms, err := uc.db.ValidatorAgg.GetAllForHeightGreaterThan(*height, page)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToAggListView(ms), nil
+	var nextCursor *store.Cursor
+	if len(ms) > 0 {
+		last := ms[len(ms)-1]
+		nextCursor = page.NextCursor(len(ms), last.StartedAtHeight, last.ID)
+	}
+
+	return ToAggListView(ms, http.EncodeCursor(nextCursor)), nil
 }
 
 
@@ -29,6 +29,8 @@ func NewGetForMinHeightHttpHandler(db *store.Store, c *client.Client) *getForMin
 
 type GetForMinHeightRequest struct {
 	Height *int64 `uri:"height" binding:"required"`
+
+	http.PaginationRequest
 }
 
 func (h *getForMinHeightHttpHandler) Handle(c *gin.Context) {
@@ -38,7 +40,18 @@ func (h *getForMinHeightHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Height)
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid pagination parameters"))
+		return
+	}
+
+	page, err := req.ToPagination()
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Height, *page)
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -0,0 +1,214 @@
+package validator
+
+import (
+	"sort"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	// leaderboardRewardsDays is the period of rewards and uptime of leaderboard
+	leaderboardRewardsDays = 30
+)
+
+type getLeaderboardUseCase struct {
+	db *store.Store
+}
+
+func NewGetLeaderboardUseCase(db *store.Store) *getLeaderboardUseCase {
+	return &getLeaderboardUseCase{
+		db: db,
+	}
+}
+
+// Execute ranks validators by sort metric. Only validators in the most recent validator set are ranked when active is true.
+// Rank changes compare with ranking of the same validators 24 hours and 7 days before the most recent indexed height
+func (uc *getLeaderboardUseCase) Execute(sortBy LeaderboardSort, order LeaderboardOrder, limit int64, active bool) (*LeaderboardView, error) {
+	syncable, err := uc.db.Syncables.FindMostRecent()
+	if err != nil {
+		return nil, err
+	}
+	now := syncable.Time.Time
+
+	validators, err := uc.db.ValidatorAgg.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		validators = filterActive(validators)
+	}
+
+	uptimes, err := uc.getUptimes(now)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := uc.getRewards(now)
+	if err != nil {
+		return nil, err
+	}
+	delegators, err := uc.getDelegators(syncable.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]LeaderboardItem, len(validators))
+	current := map[string]float64{}
+	for i, v := range validators {
+		item := ToLeaderboardItem(v, uptimes[v.Address], rewards[v.Address], delegators[v.Address])
+		item.EstimatedAPR = estimateAPR(rewards[v.Address].TotalRewards, v.RecentActiveEscrowBalance, leaderboardRewardsDays)
+
+		items[i] = item
+		current[v.Address] = item.metric(sortBy)
+	}
+
+	ranks := rankBy(current, order)
+
+	previous := map[time.Duration]map[string]int64{}
+	for _, ago := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour} {
+		past, err := uc.db.Syncables.FindMostRecentAt(now.Add(-ago))
+		if err != nil {
+			if err == store.ErrNotFound {
+				previous[ago] = map[string]int64{}
+				continue
+			}
+			return nil, err
+		}
+
+		values, err := uc.getMetric(sortBy, past)
+		if err != nil {
+			return nil, err
+		}
+		for address := range values {
+			if _, ok := current[address]; !ok {
+				delete(values, address)
+			}
+		}
+		previous[ago] = rankBy(values, order)
+	}
+
+	for i := range items {
+		address := items[i].Address
+		items[i].Rank = ranks[address]
+		items[i].RankChange24h = rankChange(address, items[i].Rank, previous[24*time.Hour])
+		items[i].RankChange7d = rankChange(address, items[i].Rank, previous[7*24*time.Hour])
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Rank < items[j].Rank
+	})
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return ToLeaderboardView(sortBy, order, items), nil
+}
+
+// getMetric returns values of sort metric of validators at height of given syncable.
+// Voting power and commission are read from validator sequences, the source of recent values of validator aggregates
+func (uc *getLeaderboardUseCase) getMetric(sortBy LeaderboardSort, syncable *model.Syncable) (map[string]float64, error) {
+	values := map[string]float64{}
+	t := syncable.Time.Time
+
+	switch sortBy {
+	case LeaderboardSortVotingPower, LeaderboardSortCommission:
+		sequences, err := uc.db.ValidatorSeq.FindByHeight(syncable.Height)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sequences {
+			if sortBy == LeaderboardSortVotingPower {
+				values[s.Address] = float64(s.VotingPower)
+			} else {
+				values[s.Address] = quantityToFloat(s.Commission)
+			}
+		}
+	case LeaderboardSortUptime:
+		uptimes, err := uc.getUptimes(t)
+		if err != nil {
+			return nil, err
+		}
+		for address, uptime := range uptimes {
+			values[address] = uptime
+		}
+	case LeaderboardSortRewards30d:
+		rewards, err := uc.getRewards(t)
+		if err != nil {
+			return nil, err
+		}
+		for address, r := range rewards {
+			values[address] = quantityToFloat(totalRewards(r))
+		}
+	case LeaderboardSortDelegators:
+		delegators, err := uc.getDelegators(syncable.Height)
+		if err != nil {
+			return nil, err
+		}
+		for address, count := range delegators {
+			values[address] = float64(count)
+		}
+	}
+	return values, nil
+}
+
+// getUptimes returns uptime of validators in rewards period ending at given time
+func (uc *getLeaderboardUseCase) getUptimes(t time.Time) (map[string]float64, error) {
+	rows, err := uc.db.ValidatorSummary.FindUptimeForPeriod(types.IntervalDaily, t.AddDate(0, 0, -leaderboardRewardsDays), t)
+	if err != nil {
+		return nil, err
+	}
+
+	uptimes := map[string]float64{}
+	for _, row := range rows {
+		uptimes[row.Address] = row.Uptime
+	}
+	return uptimes, nil
+}
+
+// getRewards returns rewards of escrow accounts in rewards period ending at given time
+func (uc *getLeaderboardUseCase) getRewards(t time.Time) (map[string]store.EscrowRewardsRow, error) {
+	rows, err := uc.db.BalanceSummary.FindRewardsByEscrow(types.IntervalDaily, t.AddDate(0, 0, -leaderboardRewardsDays), t)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := map[string]store.EscrowRewardsRow{}
+	for _, row := range rows {
+		rewards[row.EscrowAddress] = row
+	}
+	return rewards, nil
+}
+
+// getDelegators returns number of delegators of validators at given height from validator sequences
+func (uc *getLeaderboardUseCase) getDelegators(height int64) (map[string]int64, error) {
+	sequences, err := uc.db.ValidatorSeq.FindByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	delegators := map[string]int64{}
+	for _, s := range sequences {
+		delegators[s.Address] = s.Delegators
+	}
+	return delegators, nil
+}
+
+// filterActive returns validators of the most recent validator set
+func filterActive(validators []model.ValidatorAgg) []model.ValidatorAgg {
+	var recentHeight int64
+	for _, v := range validators {
+		if v.RecentAsValidatorHeight > recentHeight {
+			recentHeight = v.RecentAsValidatorHeight
+		}
+	}
+
+	var result []model.ValidatorAgg
+	for _, v := range validators {
+		if v.RecentAsValidatorHeight == recentHeight {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getLeaderboardHttpHandler)(nil)
+)
+
+type getLeaderboardHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getLeaderboardUseCase
+}
+
+func NewGetLeaderboardHttpHandler(db *store.Store, c *client.Client) *getLeaderboardHttpHandler {
+	return &getLeaderboardHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetLeaderboardRequest struct {
+	Sort   LeaderboardSort  `form:"sort" binding:"-"`
+	Order  LeaderboardOrder `form:"order" binding:"-"`
+	Limit  int64            `form:"limit" binding:"-"`
+	Active *bool            `form:"active" binding:"-"`
+}
+
+func (h *getLeaderboardHttpHandler) Handle(c *gin.Context) {
+	req, err := h.validateParams(c)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Sort, req.Order, req.Limit, req.Active == nil || *req.Active)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getLeaderboardHttpHandler) validateParams(c *gin.Context) (*GetLeaderboardRequest, error) {
+	var req GetLeaderboardRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return nil, err
+	}
+
+	if req.Sort == "" {
+		req.Sort = LeaderboardSortVotingPower
+	}
+	if !req.Sort.Valid() {
+		return nil, ErrInvalidLeaderboardSort
+	}
+
+	if req.Order == "" {
+		req.Order = req.Sort.DefaultOrder()
+	}
+	if !req.Order.Valid() {
+		return nil, ErrInvalidLeaderboardOrder
+	}
+
+	if req.Limit == 0 {
+		req.Limit = http.DefaultPageLimit
+	}
+	if req.Limit < 0 || req.Limit > http.MaxPageLimit {
+		return nil, http.ErrInvalidPageLimit
+	}
+
+	return &req, nil
+}
+
+func (h *getLeaderboardHttpHandler) getUseCase() *getLeaderboardUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetLeaderboardUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetLeaderboardUseCase_Execute(t *testing.T) {
+	now := time.Date(2020, 8, 10, 12, 0, 0, 0, time.UTC)
+	dayAgo := now.Add(-24 * time.Hour)
+	weekAgo := now.Add(-7 * 24 * time.Hour)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncablesStore := mock_store.NewMockSyncablesStore(ctrl)
+	validatorAggStore := mock_store.NewMockValidatorAggStore(ctrl)
+	validatorSeqStore := mock_store.NewMockValidatorSeqStore(ctrl)
+	validatorSummaryStore := mock_store.NewMockValidatorSummaryStore(ctrl)
+	balanceSummaryStore := mock_store.NewMockBalanceSummaryStore(ctrl)
+
+	syncablesStore.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 1000, Time: *types.NewTimeFromTime(now)}, nil).Times(1)
+	syncablesStore.EXPECT().FindMostRecentAt(dayAgo).Return(&model.Syncable{Height: 900, Time: *types.NewTimeFromTime(dayAgo)}, nil).Times(1)
+	syncablesStore.EXPECT().FindMostRecentAt(weekAgo).Return(nil, store.ErrNotFound).Times(1)
+
+	validatorAggStore.EXPECT().FindAll().Return([]model.ValidatorAgg{
+		{Address: "a", RecentVotingPower: 100, RecentActiveEscrowBalance: types.NewQuantityFromInt64(1000)},
+		{Address: "b", RecentVotingPower: 50, RecentActiveEscrowBalance: types.NewQuantityFromInt64(1000)},
+	}, nil).Times(1)
+	validatorSummaryStore.EXPECT().FindUptimeForPeriod(types.IntervalDaily, now.AddDate(0, 0, -leaderboardRewardsDays), now).Return([]store.ValidatorPeriodUptimeRow{{Address: "a", Uptime: 1}}, nil).Times(1)
+	balanceSummaryStore.EXPECT().FindRewardsByEscrow(types.IntervalDaily, now.AddDate(0, 0, -leaderboardRewardsDays), now).Return([]store.EscrowRewardsRow{
+		{EscrowAddress: "a", TotalRewards: types.NewQuantityFromInt64(10), TotalCommission: types.NewQuantityFromInt64(1)},
+	}, nil).Times(1)
+	validatorSeqStore.EXPECT().FindByHeight(int64(1000)).Return([]model.ValidatorSeq{
+		{Address: "a", VotingPower: 100},
+		{Address: "b", VotingPower: 50, Delegators: 3},
+	}, nil).Times(1)
+	validatorSeqStore.EXPECT().FindByHeight(int64(900)).Return([]model.ValidatorSeq{
+		{Address: "a", VotingPower: 40},
+		{Address: "b", VotingPower: 60},
+		{Address: "c", VotingPower: 80},
+	}, nil).Times(1)
+
+	uc := NewGetLeaderboardUseCase(&store.Store{
+		Syncables:        syncablesStore,
+		ValidatorAgg:     validatorAggStore,
+		ValidatorSeq:     validatorSeqStore,
+		ValidatorSummary: validatorSummaryStore,
+		BalanceSummary:   balanceSummaryStore,
+	})
+
+	view, err := uc.Execute(LeaderboardSortVotingPower, LeaderboardOrderDesc, 10, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(view.Items) != 2 {
+		t.Fatalf("unexpected number of items, want %d; got %d", 2, len(view.Items))
+	}
+
+	tests := []struct {
+		address       string
+		rank          int64
+		rankChange24h int64
+		delegators    int64
+	}{
+		{"a", 1, 1, 0},
+		{"b", 2, -1, 3},
+	}
+
+	for i, tt := range tests {
+		item := view.Items[i]
+		if item.Address != tt.address {
+			t.Errorf("unexpected address at %d, want %s; got %s", i, tt.address, item.Address)
+		}
+		if item.Rank != tt.rank {
+			t.Errorf("unexpected rank of %s, want %d; got %d", tt.address, tt.rank, item.Rank)
+		}
+		if item.RankChange24h == nil || *item.RankChange24h != tt.rankChange24h {
+			t.Errorf("unexpected 24h rank change of %s, want %d; got %v", tt.address, tt.rankChange24h, item.RankChange24h)
+		}
+		if item.RankChange7d != nil {
+			t.Errorf("unexpected 7d rank change of %s, want nil; got %d", tt.address, *item.RankChange7d)
+		}
+		if item.Delegators != tt.delegators {
+			t.Errorf("unexpected delegators of %s, want %d; got %d", tt.address, tt.delegators, item.Delegators)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type getReturnsUseCase struct {
+	db *store.Store
+}
+
+func NewGetReturnsUseCase(db *store.Store) *getReturnsUseCase {
+	return &getReturnsUseCase{
+		db: db,
+	}
+}
+
+// Execute computes realized reward rate of validator in given number of complete days before the day of the most recent indexed height.
+// Rate divides rewards of delegators (net of commission) by average active escrow balance in the period
+func (uc *getReturnsUseCase) Execute(address string, days int64) (*ReturnsView, error) {
+	if _, err := uc.db.ValidatorAgg.FindByAddress(address); err != nil {
+		return nil, err
+	}
+
+	syncable, err := uc.db.Syncables.FindMostRecent()
+	if err != nil {
+		return nil, err
+	}
+	// Period covers whole daily buckets, the bucket of most recent height is not complete yet
+	end := types.IntervalDaily.Truncate(syncable.Time.Time)
+	start := end.AddDate(0, 0, -int(days))
+
+	rewardRows, err := uc.db.BalanceSummary.FindRewardsByEscrow(types.IntervalDaily, start, end)
+	if err != nil {
+		return nil, err
+	}
+	balanceRows, err := uc.db.ValidatorSummary.FindActiveEscrowBalanceForPeriod(types.IntervalDaily, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := map[string]store.EscrowRewardsRow{}
+	for _, row := range rewardRows {
+		rewards[row.EscrowAddress] = row
+	}
+
+	view := &ReturnsView{
+		Address:   address,
+		Days:      days,
+		StartTime: start,
+		EndTime:   end,
+	}
+
+	var networkRates []float64
+	for _, row := range balanceRows {
+		if row.ActiveEscrowBalanceAvg.Sign() <= 0 {
+			continue
+		}
+		rate := rewardRate(rewards[row.Address].TotalRewards, row.ActiveEscrowBalanceAvg)
+		networkRates = append(networkRates, rate)
+
+		if row.Address == address {
+			view.ActiveEscrowBalanceAvg = row.ActiveEscrowBalanceAvg
+			view.Rewards = rewards[row.Address].TotalRewards
+			view.Commission = rewards[row.Address].TotalCommission
+			view.GrossRewardRate = rewardRate(totalRewards(rewards[row.Address]), row.ActiveEscrowBalanceAvg)
+			view.RewardRate = rate
+			view.APR = annualize(rate, days)
+			view.APY = compound(rate, days)
+		}
+	}
+
+	networkRate := median(networkRates)
+	view.Network = ReturnsNetworkView{
+		ValidatorsCount:  int64(len(networkRates)),
+		MedianRewardRate: networkRate,
+		MedianAPR:        annualize(networkRate, days),
+		MedianAPY:        compound(networkRate, days),
+	}
+
+	return view, nil
+}
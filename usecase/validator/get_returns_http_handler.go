@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getReturnsHttpHandler)(nil)
+)
+
+type getReturnsHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getReturnsUseCase
+}
+
+func NewGetReturnsHttpHandler(db *store.Store, c *client.Client) *getReturnsHttpHandler {
+	return &getReturnsHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetReturnsRequest struct {
+	Address string `uri:"address" binding:"required"`
+	Period  string `form:"period" binding:"-"`
+}
+
+func (h *getReturnsHttpHandler) Handle(c *gin.Context) {
+	var req GetReturnsRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	if req.Period == "" {
+		req.Period = DefaultReturnsPeriod
+	}
+	days, err := parseReturnsPeriod(req.Period)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, days)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getReturnsHttpHandler) getUseCase() *getReturnsUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetReturnsUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetReturnsUseCase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncablesStore := mock_store.NewMockSyncablesStore(ctrl)
+	validatorAggStore := mock_store.NewMockValidatorAggStore(ctrl)
+	validatorSummaryStore := mock_store.NewMockValidatorSummaryStore(ctrl)
+	balanceSummaryStore := mock_store.NewMockBalanceSummaryStore(ctrl)
+
+	start := time.Date(2020, 8, 3, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 8, 10, 0, 0, 0, 0, time.UTC)
+
+	validatorAggStore.EXPECT().FindByAddress("a").Return(&model.ValidatorAgg{Address: "a"}, nil).Times(1)
+	syncablesStore.EXPECT().FindMostRecent().Return(&model.Syncable{Height: 1000, Time: *types.NewTimeFromTime(time.Date(2020, 8, 10, 15, 30, 0, 0, time.UTC))}, nil).Times(1)
+	balanceSummaryStore.EXPECT().FindRewardsByEscrow(types.IntervalDaily, start, end).Return([]store.EscrowRewardsRow{
+		{EscrowAddress: "a", TotalRewards: types.NewQuantityFromInt64(10), TotalCommission: types.NewQuantityFromInt64(1)},
+	}, nil).Times(1)
+	validatorSummaryStore.EXPECT().FindActiveEscrowBalanceForPeriod(types.IntervalDaily, start, end).Return([]store.ValidatorPeriodBalanceRow{
+		{Address: "a", ActiveEscrowBalanceAvg: types.NewQuantityFromInt64(1000)},
+	}, nil).Times(1)
+
+	uc := NewGetReturnsUseCase(&store.Store{
+		Syncables:        syncablesStore,
+		ValidatorAgg:     validatorAggStore,
+		ValidatorSummary: validatorSummaryStore,
+		BalanceSummary:   balanceSummaryStore,
+	})
+
+	view, err := uc.Execute("a", 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !view.StartTime.Equal(start) || !view.EndTime.Equal(end) {
+		t.Errorf("unexpected period, want [%v, %v); got [%v, %v)", start, end, view.StartTime, view.EndTime)
+	}
+	if !view.Rewards.Equals(types.NewQuantityFromInt64(10)) {
+		t.Errorf("unexpected rewards, want %d; got %s", 10, view.Rewards.String())
+	}
+}
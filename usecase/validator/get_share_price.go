@@ -0,0 +1,32 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type getSharePriceUseCase struct {
+	db *store.Store
+}
+
+func NewGetSharePriceUseCase(db *store.Store) *getSharePriceUseCase {
+	return &getSharePriceUseCase{
+		db: db,
+	}
+}
+
+// Execute returns share price of validator escrow pool in summaries of given interval and period
+func (uc *getSharePriceUseCase) Execute(address string, interval types.SummaryInterval, period string) (*SharePriceView, error) {
+	summaries, err := uc.db.ValidatorSummary.FindSummaryByAddress(address, interval, period)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		if _, err := uc.db.ValidatorAgg.FindByAddress(address); err != nil {
+			return nil, err
+		}
+	}
+
+	return ToSharePriceView(address, interval, period, summaries), nil
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultSharePriceInterval = types.IntervalDaily
+	DefaultSharePricePeriod   = "30 days"
+)
+
+var (
+	_ types.HttpHandler = (*getSharePriceHttpHandler)(nil)
+)
+
+type getSharePriceHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getSharePriceUseCase
+}
+
+func NewGetSharePriceHttpHandler(db *store.Store, c *client.Client) *getSharePriceHttpHandler {
+	return &getSharePriceHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetSharePriceRequest struct {
+	Address  string                `uri:"address" binding:"required"`
+	Interval types.SummaryInterval `form:"interval" binding:"-"`
+	Period   string                `form:"period" binding:"-"`
+}
+
+func (h *getSharePriceHttpHandler) Handle(c *gin.Context) {
+	var req GetSharePriceRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	if req.Interval == "" {
+		req.Interval = DefaultSharePriceInterval
+	}
+	if !req.Interval.Valid() {
+		http.BadRequest(c, types.ErrInvalidSummaryInterval)
+		return
+	}
+	if req.Period == "" {
+		req.Period = DefaultSharePricePeriod
+	}
+	period, err := parseSharePricePeriod(req.Period)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, req.Interval, period)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getSharePriceHttpHandler) getUseCase() *getSharePriceUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSharePriceUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,60 @@
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetSharePriceHttpHandler_Handle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		description    string
+		period         string
+		expectedStatus int
+		expectedPeriod string
+	}{
+		{"uses default period", "", http.StatusOK, "30 days"},
+		{"normalizes period", "7days", http.StatusOK, "7 days"},
+		{"rejects invalid period", "7 fortnights", http.StatusBadRequest, ""},
+		{"rejects period with sql", "1 day'::INTERVAL; --", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			summaryStore := mock_store.NewMockValidatorSummaryStore(ctrl)
+			if tt.expectedStatus == http.StatusOK {
+				summaryStore.EXPECT().FindSummaryByAddress("a", types.IntervalDaily, tt.expectedPeriod).Return([]model.ValidatorSummary{{Summary: &model.Summary{TimeInterval: types.IntervalDaily}, Address: "a"}}, nil).Times(1)
+			}
+
+			h := NewGetSharePriceHttpHandler(&store.Store{ValidatorSummary: summaryStore}, nil)
+
+			engine := gin.New()
+			engine.GET("/validator/:address/share_price", h.Handle)
+
+			path := "/validator/a/share_price"
+			if tt.period != "" {
+				path += "?period=" + url.QueryEscape(tt.period)
+			}
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("unexpected status, want %d; got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	LeaderboardSortVotingPower LeaderboardSort = "voting_power"
+	LeaderboardSortUptime      LeaderboardSort = "uptime"
+	LeaderboardSortCommission  LeaderboardSort = "commission"
+	LeaderboardSortRewards30d  LeaderboardSort = "rewards_30d"
+	LeaderboardSortDelegators  LeaderboardSort = "delegators"
+
+	LeaderboardOrderAsc  LeaderboardOrder = "asc"
+	LeaderboardOrderDesc LeaderboardOrder = "desc"
+)
+
+var (
+	ErrInvalidLeaderboardSort  = errors.New("sort must be voting_power, uptime, commission, rewards_30d or delegators")
+	ErrInvalidLeaderboardOrder = errors.New("order must be asc or desc")
+)
+
+// LeaderboardSort is the metric validators are ranked by
+type LeaderboardSort string
+
+func (s LeaderboardSort) Valid() bool {
+	switch s {
+	case LeaderboardSortVotingPower, LeaderboardSortUptime, LeaderboardSortCommission, LeaderboardSortRewards30d, LeaderboardSortDelegators:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultOrder returns order in which the best validator is ranked first
+func (s LeaderboardSort) DefaultOrder() LeaderboardOrder {
+	if s == LeaderboardSortCommission {
+		return LeaderboardOrderAsc
+	}
+	return LeaderboardOrderDesc
+}
+
+type LeaderboardOrder string
+
+func (o LeaderboardOrder) Valid() bool {
+	return o == LeaderboardOrderAsc || o == LeaderboardOrderDesc
+}
+
+// rankBy returns 1-based ranks of addresses ordered by metric values. Ties are ordered by address
+func rankBy(values map[string]float64, order LeaderboardOrder) map[string]int64 {
+	addresses := make([]string, 0, len(values))
+	for address := range values {
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		a, b := values[addresses[i]], values[addresses[j]]
+		if a == b {
+			return addresses[i] < addresses[j]
+		}
+		if order == LeaderboardOrderAsc {
+			return a < b
+		}
+		return a > b
+	})
+
+	ranks := make(map[string]int64, len(addresses))
+	for i, address := range addresses {
+		ranks[address] = int64(i + 1)
+	}
+	return ranks
+}
+
+// rankChange returns how many places validator moved up since previous ranking or nil when it wasn't ranked
+func rankChange(address string, rank int64, previous map[string]int64) *int64 {
+	prev, ok := previous[address]
+	if !ok {
+		return nil
+	}
+	change := prev - rank
+	return &change
+}
+
+// estimateAPR annualizes rewards earned by delegators in given number of days relative to escrow balance
+func estimateAPR(rewards types.Quantity, balance types.Quantity, days int64) float64 {
+	return annualize(rewardRate(rewards, balance), days)
+}
+
+func quantityToFloat(q types.Quantity) float64 {
+	value, _ := new(big.Float).SetInt(&q.Int).Float64()
+	return value
+}
+
+// totalRewards returns rewards of delegators together with commission of validator
+func totalRewards(row store.EscrowRewardsRow) types.Quantity {
+	return types.NewQuantity(new(big.Int).Add(&row.TotalRewards.Int, &row.TotalCommission.Int))
+}
@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+func TestRankBy(t *testing.T) {
+	values := map[string]float64{"a": 10, "b": 30, "c": 20, "d": 20}
+
+	tests := []struct {
+		order LeaderboardOrder
+		want  map[string]int64
+	}{
+		{LeaderboardOrderDesc, map[string]int64{"b": 1, "c": 2, "d": 3, "a": 4}},
+		{LeaderboardOrderAsc, map[string]int64{"a": 1, "c": 2, "d": 3, "b": 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.order), func(t *testing.T) {
+			ranks := rankBy(values, tt.order)
+			for address, want := range tt.want {
+				if ranks[address] != want {
+					t.Errorf("unexpected rank of %s, want %d; got %d", address, want, ranks[address])
+				}
+			}
+		})
+	}
+}
+
+func TestRankChange(t *testing.T) {
+	previous := map[string]int64{"a": 3, "b": 1}
+
+	if change := rankChange("a", 1, previous); change == nil || *change != 2 {
+		t.Errorf("unexpected rank change of a, want 2; got %v", change)
+	}
+	if change := rankChange("b", 2, previous); change == nil || *change != -1 {
+		t.Errorf("unexpected rank change of b, want -1; got %v", change)
+	}
+	if change := rankChange("c", 3, previous); change != nil {
+		t.Errorf("unexpected rank change of c, want nil; got %v", *change)
+	}
+}
+
+func TestEstimateAPR(t *testing.T) {
+	tests := []struct {
+		description string
+		rewards     int64
+		balance     int64
+		want        float64
+	}{
+		{"annualizes rewards", 30, 3650, 0.1},
+		{"returns 0 without balance", 30, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := estimateAPR(types.NewQuantity(big.NewInt(tt.rewards)), types.NewQuantity(big.NewInt(tt.balance)), 30)
+			if got < tt.want-1e-9 || got > tt.want+1e-9 {
+				t.Errorf("unexpected apr, want %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterActive(t *testing.T) {
+	validators := []model.ValidatorAgg{
+		{Address: "a", RecentAsValidatorHeight: 100},
+		{Address: "b", RecentAsValidatorHeight: 90},
+		{Address: "c", RecentAsValidatorHeight: 100},
+	}
+
+	active := filterActive(validators)
+	if len(active) != 2 || active[0].Address != "a" || active[1].Address != "c" {
+		t.Errorf("unexpected active validators: %v", active)
+	}
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidMuteWindow = errors.New("end time must be after start time")
+)
+
+type muteUseCase struct {
+	db *store.Store
+}
+
+func NewMuteUseCase(db *store.Store) *muteUseCase {
+	return &muteUseCase{
+		db: db,
+	}
+}
+
+// Execute hides system events of validator from subscriber between start and end time.
+// Mute starts immediately when start time is not provided
+func (uc *muteUseCase) Execute(address string, subscriber string, startTime *time.Time, endTime time.Time, reason *string) (*model.ValidatorMute, error) {
+	start := time.Now()
+	if startTime != nil {
+		start = *startTime
+	}
+
+	if !endTime.After(start) {
+		return nil, ErrInvalidMuteWindow
+	}
+
+	mute := &model.ValidatorMute{
+		Address:    address,
+		Subscriber: subscriber,
+		StartTime:  *types.NewTimeFromTime(start),
+		EndTime:    *types.NewTimeFromTime(endTime),
+		Reason:     reason,
+	}
+	if err := uc.db.ValidatorMutes.Create(mute); err != nil {
+		return nil, err
+	}
+	return mute, nil
+}
@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*muteHttpHandler)(nil)
+)
+
+type muteHttpHandler struct {
+	db *store.Store
+
+	useCase *muteUseCase
+}
+
+func NewMuteHttpHandler(db *store.Store) *muteHttpHandler {
+	return &muteHttpHandler{
+		db: db,
+	}
+}
+
+type ValidatorRequest struct {
+	Address string `uri:"address" binding:"required"`
+}
+
+// MuteRequest is the body of mute request.
+// Subscriber defaults to name of api key request was authenticated with
+type MuteRequest struct {
+	Subscriber string     `json:"subscriber" binding:"-"`
+	StartTime  *time.Time `json:"start_time" binding:"-"`
+	EndTime    time.Time  `json:"end_time" binding:"required"`
+	Reason     *string    `json:"reason" binding:"-"`
+}
+
+func (h *muteHttpHandler) Handle(c *gin.Context) {
+	var uriReq ValidatorRequest
+	if err := c.ShouldBindUri(&uriReq); err != nil {
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+
+	var req MuteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid mute window"))
+		return
+	}
+
+	subscriber, err := http.Subscriber(c, req.Subscriber)
+	if err == http.ErrSubscriberNotAllowed {
+		http.Forbidden(c, err)
+		return
+	}
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(uriReq.Address, subscriber, req.StartTime, req.EndTime, req.Reason)
+	if err == ErrInvalidMuteWindow {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *muteHttpHandler) getUseCase() *muteUseCase {
+	if h.useCase == nil {
+		h.useCase = NewMuteUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	apihttp "github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMuteHttpHandler_Handle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		description        string
+		body               string
+		apiKey             *model.APIKey
+		expectedStatus     int
+		expectedSubscriber string
+	}{
+		{"mutes validator for subscriber of anonymous request", `{"subscriber":"alice","end_time":"2100-01-01T00:00:00Z"}`, nil, http.StatusOK, "alice"},
+		{"mutes validator for api key", `{"end_time":"2100-01-01T00:00:00Z","reason":"maintenance"}`, &model.APIKey{Name: "bob"}, http.StatusOK, "bob"},
+		{"rejects subscriber of other api key", `{"subscriber":"alice","end_time":"2100-01-01T00:00:00Z"}`, &model.APIKey{Name: "bob"}, http.StatusForbidden, ""},
+		{"rejects anonymous request without subscriber", `{"end_time":"2100-01-01T00:00:00Z"}`, nil, http.StatusBadRequest, ""},
+		{"rejects missing end time", `{"subscriber":"alice"}`, nil, http.StatusBadRequest, ""},
+		{"rejects end time before start time", `{"subscriber":"alice","start_time":"2100-01-02T00:00:00Z","end_time":"2100-01-01T00:00:00Z"}`, nil, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mutesStore := mock_store.NewMockValidatorMutesStore(ctrl)
+			if tt.expectedStatus == http.StatusOK {
+				mutesStore.EXPECT().Create(gomock.Any()).DoAndReturn(func(val interface{}) error {
+					mute := val.(*model.ValidatorMute)
+					if mute.Address != "validator1" {
+						t.Errorf("unexpected address, want %s; got %s", "validator1", mute.Address)
+					}
+					if mute.Subscriber != tt.expectedSubscriber {
+						t.Errorf("unexpected subscriber, want %s; got %s", tt.expectedSubscriber, mute.Subscriber)
+					}
+					return nil
+				}).Times(1)
+			}
+
+			h := NewMuteHttpHandler(&store.Store{ValidatorMutes: mutesStore})
+
+			engine := gin.New()
+			engine.POST("/validators/:address/mute", func(c *gin.Context) {
+				if tt.apiKey != nil {
+					apihttp.SetAPIKey(c, tt.apiKey)
+				}
+			}, h.Handle)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/validators/validator1/mute", strings.NewReader(tt.body)))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("unexpected status, want %d; got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
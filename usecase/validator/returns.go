@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultReturnsPeriod = "30d"
+	MaxReturnsPeriodDays = 365
+)
+
+var (
+	ErrInvalidReturnsPeriod = errors.New("period must be number of days between 1d and 365d")
+
+	returnsPeriodRegexp = regexp.MustCompile(`^(\d+)d$`)
+
+	ErrInvalidSharePricePeriod = errors.New("period must be positive number of hours, days, weeks, months or years (ie. 30 days)")
+
+	sharePricePeriodRegexp = regexp.MustCompile(`^(\d+) ?(hour|day|week|month|year)s?$`)
+)
+
+// parseReturnsPeriod returns number of days of period in format of 30d
+func parseReturnsPeriod(period string) (int64, error) {
+	match := returnsPeriodRegexp.FindStringSubmatch(period)
+	if match == nil {
+		return 0, ErrInvalidReturnsPeriod
+	}
+
+	days, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil || days < 1 || days > MaxReturnsPeriodDays {
+		return 0, ErrInvalidReturnsPeriod
+	}
+	return days, nil
+}
+
+// parseSharePricePeriod returns period in format of 30 days which can be used as database interval
+func parseSharePricePeriod(period string) (string, error) {
+	match := sharePricePeriodRegexp.FindStringSubmatch(period)
+	if match == nil {
+		return "", ErrInvalidSharePricePeriod
+	}
+
+	count, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil || count < 1 {
+		return "", ErrInvalidSharePricePeriod
+	}
+	return fmt.Sprintf("%d %ss", count, match[2]), nil
+}
+
+// rewardRate returns rewards relative to balance
+func rewardRate(rewards types.Quantity, balance types.Quantity) float64 {
+	if balance.Sign() <= 0 {
+		return 0
+	}
+
+	rate, _ := new(big.Float).Quo(new(big.Float).SetInt(&rewards.Int), new(big.Float).SetInt(&balance.Int)).Float64()
+	return rate
+}
+
+// annualize returns simple annual rate of rate earned in given number of days
+func annualize(rate float64, days int64) float64 {
+	if days <= 0 {
+		return 0
+	}
+	return rate * 365 / float64(days)
+}
+
+// compound returns annual yield of rate earned in given number of days when rewards are compounded every period
+func compound(rate float64, days int64) float64 {
+	if days <= 0 {
+		return 0
+	}
+	return math.Pow(1+rate, 365/float64(days)) - 1
+}
+
+// median returns median of values or 0 when there are no values
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// sharePriceGrowth returns relative change of share price between first and last item with known price
+func sharePriceGrowth(items []SharePriceItem) float64 {
+	var first, last float64
+	for _, item := range items {
+		if item.SharePriceAvg <= 0 {
+			continue
+		}
+		if first == 0 {
+			first = item.SharePriceAvg
+		}
+		last = item.SharePriceAvg
+	}
+
+	if first == 0 {
+		return 0
+	}
+	return last/first - 1
+}
@@ -0,0 +1,136 @@
+package validator
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+func TestParseReturnsPeriod(t *testing.T) {
+	tests := []struct {
+		period  string
+		want    int64
+		wantErr bool
+	}{
+		{"30d", 30, false},
+		{"1d", 1, false},
+		{"365d", 365, false},
+		{"0d", 0, true},
+		{"366d", 0, true},
+		{"30", 0, true},
+		{"24h", 0, true},
+		{"-1d", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			got, err := parseReturnsPeriod(tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("unexpected days, want %d; got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseSharePricePeriod(t *testing.T) {
+	tests := []struct {
+		period  string
+		want    string
+		wantErr bool
+	}{
+		{"30 days", "30 days", false},
+		{"24 hours", "24 hours", false},
+		{"1 month", "1 months", false},
+		{"2weeks", "2 weeks", false},
+		{"0 days", "", true},
+		{"30", "", true},
+		{"30 days; DROP TABLE validator_summary", "", true},
+		{"-1 days", "", true},
+		{"99999999999999999999 days", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			got, err := parseSharePricePeriod(tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("unexpected period, want %q; got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRewardRate(t *testing.T) {
+	rewards := types.NewQuantity(big.NewInt(5))
+
+	if rate := rewardRate(rewards, types.NewQuantity(big.NewInt(1000))); !almostEqual(rate, 0.005) {
+		t.Errorf("unexpected rate, want 0.005; got %v", rate)
+	}
+	if rate := rewardRate(rewards, types.NewQuantity(big.NewInt(0))); rate != 0 {
+		t.Errorf("unexpected rate without balance, want 0; got %v", rate)
+	}
+}
+
+func TestAnnualize(t *testing.T) {
+	if apr := annualize(0.01, 73); !almostEqual(apr, 0.05) {
+		t.Errorf("unexpected apr, want 0.05; got %v", apr)
+	}
+	if apy := compound(0.01, 73); !almostEqual(apy, math.Pow(1.01, 5)-1) {
+		t.Errorf("unexpected apy, want %v; got %v", math.Pow(1.01, 5)-1, apy)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   float64
+	}{
+		{nil, 0},
+		{[]float64{3}, 3},
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	}
+
+	for _, tt := range tests {
+		if got := median(tt.values); got != tt.want {
+			t.Errorf("unexpected median of %v, want %v; got %v", tt.values, tt.want, got)
+		}
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSharePriceGrowth(t *testing.T) {
+	tests := []struct {
+		description string
+		prices      []float64
+		want        float64
+	}{
+		{"returns change between first and last price", []float64{1.0, 1.01, 1.02}, 0.02},
+		{"skips buckets without price", []float64{0, 1.0, 1.05, 0}, 0.05},
+		{"returns 0 without prices", []float64{0, 0}, 0},
+		{"returns 0 without items", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var items []SharePriceItem
+			for _, price := range tt.prices {
+				items = append(items, SharePriceItem{SharePriceAvg: price})
+			}
+
+			if got := sharePriceGrowth(items); !almostEqual(got, tt.want) {
+				t.Errorf("unexpected growth, want %v; got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,17 +1,30 @@
 package validator
 
 import (
+	"time"
+
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
 )
 
 type AggListView struct {
-	Items []model.ValidatorAgg `json:"items"`
+	Items      []model.ValidatorAgg `json:"items"`
+	NextCursor *string              `json:"next_cursor"`
+}
+
+func (v AggListView) PageItems() interface{} {
+	return v.Items
+}
+
+func (v AggListView) PageNextCursor() *string {
+	return v.NextCursor
 }
 
-func ToAggListView(ms []model.ValidatorAgg) *AggListView {
+func ToAggListView(ms []model.ValidatorAgg, nextCursor *string) *AggListView {
 	return &AggListView{
-		Items: ms,
+		Items:      ms,
+		NextCursor: nextCursor,
 	}
 }
 
@@ -33,9 +46,10 @@ type AggDetailsView struct {
 	EntityName                string         `json:"entity_name"`
 
 	LastSequences []model.ValidatorSeq `json:"last_sequences"`
+	NextCursor    *string              `json:"next_cursor"`
 }
 
-func ToAggDetailsView(m *model.ValidatorAgg, sequences []model.ValidatorSeq) *AggDetailsView {
+func ToAggDetailsView(m *model.ValidatorAgg, sequences []model.ValidatorSeq, nextCursor *string) *AggDetailsView {
 	return &AggDetailsView{
 		Model:     m.Model,
 		Aggregate: m.Aggregate,
@@ -54,6 +68,7 @@ func ToAggDetailsView(m *model.ValidatorAgg, sequences []model.ValidatorSeq) *Ag
 		EntityName:                m.EntityName,
 
 		LastSequences: sequences,
+		NextCursor:    nextCursor,
 	}
 }
 
@@ -111,3 +126,125 @@ func ToSeqListView(validatorSeqs []model.ValidatorSeq, validatorAggs []model.Val
 		Items: items,
 	}
 }
+
+type LeaderboardItem struct {
+	Rank          int64  `json:"rank"`
+	RankChange24h *int64 `json:"rank_change_24h"`
+	RankChange7d  *int64 `json:"rank_change_7d"`
+
+	Address             string         `json:"address"`
+	EntityUID           string         `json:"entity_uid"`
+	EntityName          string         `json:"entity_name"`
+	LogoURL             string         `json:"logo_url"`
+	VotingPower         int64          `json:"voting_power"`
+	Uptime              float64        `json:"uptime"`
+	Commission          types.Quantity `json:"commission"`
+	Rewards30d          types.Quantity `json:"rewards_30d"`
+	Delegators          int64          `json:"delegators"`
+	ActiveEscrowBalance types.Quantity `json:"active_escrow_balance"`
+	EstimatedAPR        float64        `json:"estimated_apr"`
+}
+
+// metric returns value of item used to rank it by given sort
+func (i LeaderboardItem) metric(sortBy LeaderboardSort) float64 {
+	switch sortBy {
+	case LeaderboardSortUptime:
+		return i.Uptime
+	case LeaderboardSortCommission:
+		return quantityToFloat(i.Commission)
+	case LeaderboardSortRewards30d:
+		return quantityToFloat(i.Rewards30d)
+	case LeaderboardSortDelegators:
+		return float64(i.Delegators)
+	default:
+		return float64(i.VotingPower)
+	}
+}
+
+func ToLeaderboardItem(m model.ValidatorAgg, uptime float64, rewards store.EscrowRewardsRow, delegators int64) LeaderboardItem {
+	return LeaderboardItem{
+		Address:             m.Address,
+		EntityUID:           m.EntityUID,
+		EntityName:          m.EntityName,
+		LogoURL:             m.LogoURL,
+		VotingPower:         m.RecentVotingPower,
+		Uptime:              uptime,
+		Commission:          m.RecentCommission,
+		Rewards30d:          totalRewards(rewards),
+		Delegators:          delegators,
+		ActiveEscrowBalance: m.RecentActiveEscrowBalance,
+	}
+}
+
+type LeaderboardView struct {
+	Sort  LeaderboardSort   `json:"sort"`
+	Order LeaderboardOrder  `json:"order"`
+	Items []LeaderboardItem `json:"items"`
+}
+
+func ToLeaderboardView(sortBy LeaderboardSort, order LeaderboardOrder, items []LeaderboardItem) *LeaderboardView {
+	return &LeaderboardView{
+		Sort:  sortBy,
+		Order: order,
+		Items: items,
+	}
+}
+
+type ReturnsView struct {
+	Address   string    `json:"address"`
+	Days      int64     `json:"days"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+
+	Rewards                types.Quantity `json:"rewards"`
+	Commission             types.Quantity `json:"commission"`
+	ActiveEscrowBalanceAvg types.Quantity `json:"active_escrow_balance_avg"`
+	GrossRewardRate        float64        `json:"gross_reward_rate"`
+	RewardRate             float64        `json:"reward_rate"`
+	APR                    float64        `json:"apr"`
+	APY                    float64        `json:"apy"`
+
+	Network ReturnsNetworkView `json:"network"`
+}
+
+type ReturnsNetworkView struct {
+	ValidatorsCount  int64   `json:"validators_count"`
+	MedianRewardRate float64 `json:"median_reward_rate"`
+	MedianAPR        float64 `json:"median_apr"`
+	MedianAPY        float64 `json:"median_apy"`
+}
+
+type SharePriceItem struct {
+	TimeBucket    types.Time `json:"time_bucket"`
+	SharePriceAvg float64    `json:"share_price_avg"`
+	SharePriceMax float64    `json:"share_price_max"`
+	SharePriceMin float64    `json:"share_price_min"`
+}
+
+type SharePriceView struct {
+	Address  string                `json:"address"`
+	Interval types.SummaryInterval `json:"interval"`
+	Period   string                `json:"period"`
+	Growth   float64               `json:"growth"`
+	Items    []SharePriceItem      `json:"items"`
+}
+
+func ToSharePriceView(address string, interval types.SummaryInterval, period string, summaries []model.ValidatorSummary) *SharePriceView {
+	items := make([]SharePriceItem, len(summaries))
+	for i, s := range summaries {
+		items[i] = SharePriceItem{
+			TimeBucket:    s.TimeBucket,
+			SharePriceAvg: s.SharePriceAvg,
+			SharePriceMax: s.SharePriceMax,
+			SharePriceMin: s.SharePriceMin,
+		}
+	}
+
+	return &SharePriceView{
+		Address:  address,
+		Interval: interval,
+		Period:   period,
+		Growth:   sharePriceGrowth(items),
+		Items:    items,
+	}
+}
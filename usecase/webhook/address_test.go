@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"100.64.0.1", false},
+		{"198.18.0.1", false},
+		{"198.19.255.254", false},
+		{"64:ff9b::7f00:1", false},
+		{"::ffff:127.0.0.1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := isPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("unexpected result, want %t; got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateSubscriptionUseCase_Execute_forbiddenURL(t *testing.T) {
+	tests := []struct {
+		description string
+		url         string
+		want        error
+	}{
+		{"rejects url without host", "http:///hook", ErrInvalidURL},
+		{"rejects unsupported scheme", "ftp://example.com/hook", ErrInvalidURL},
+		{"rejects loopback address", "http://127.0.0.1:8080/hook", ErrForbiddenAddress},
+		{"rejects metadata address", "http://169.254.169.254/latest", ErrForbiddenAddress},
+		{"rejects private address", "https://10.0.0.5/hook", ErrForbiddenAddress},
+		{"rejects loopback ipv6 address", "http://[::1]/hook", ErrForbiddenAddress},
+	}
+
+	uc := NewCreateSubscriptionUseCase(&config.Config{}, nil)
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), tt.url, "", nil, nil)
+			if err != tt.want {
+				t.Errorf("unexpected error, want %v; got %v", tt.want, err)
+			}
+		})
+	}
+}
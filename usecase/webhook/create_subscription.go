@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidURL = errors.New("url must be absolute http or https url")
+)
+
+type createSubscriptionUseCase struct {
+	cfg *config.Config
+	db  *store.Store
+}
+
+func NewCreateSubscriptionUseCase(cfg *config.Config, db *store.Store) *createSubscriptionUseCase {
+	return &createSubscriptionUseCase{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+func (uc *createSubscriptionUseCase) Execute(ctx context.Context, apiKey *model.APIKey, rawURL string, secret string, actor *string, kind *model.SystemEventKind) (*SubscriptionCreatedView, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return nil, ErrInvalidURL
+	}
+
+	if !uc.cfg.WebhookAllowPrivateURLs {
+		if err := checkHost(ctx, u.Hostname()); err != nil {
+			return nil, err
+		}
+	}
+
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &model.WebhookSubscription{
+		URL:         u.String(),
+		Secret:      secret,
+		Actor:       actor,
+		Kind:        kind,
+		APIKeyID:    &apiKey.ID,
+		QueuedUntil: *types.NewTimeFromTime(time.Now()),
+	}
+	if err := uc.db.WebhookSubscriptions.Create(subscription); err != nil {
+		return nil, err
+	}
+
+	return ToSubscriptionCreatedView(subscription), nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
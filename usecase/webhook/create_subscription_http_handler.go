@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*createSubscriptionHttpHandler)(nil)
+)
+
+type createSubscriptionHttpHandler struct {
+	cfg *config.Config
+	db  *store.Store
+
+	useCase *createSubscriptionUseCase
+}
+
+func NewCreateSubscriptionHttpHandler(cfg *config.Config, db *store.Store) *createSubscriptionHttpHandler {
+	return &createSubscriptionHttpHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+type CreateSubscriptionRequest struct {
+	URL    string                 `json:"url" binding:"required"`
+	Secret string                 `json:"secret" binding:"-"`
+	Actor  *string                `json:"actor" binding:"-"`
+	Kind   *model.SystemEventKind `json:"kind" binding:"-"`
+}
+
+func (h *createSubscriptionHttpHandler) Handle(c *gin.Context) {
+	apiKey := http.APIKey(c)
+	if apiKey == nil {
+		http.Unauthorized(c, ErrAPIKeyRequired)
+		return
+	}
+
+	var req CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid subscription"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(c.Request.Context(), apiKey, req.URL, req.Secret, req.Actor, req.Kind)
+	if err == ErrInvalidURL || err == ErrForbiddenAddress {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *createSubscriptionHttpHandler) getUseCase() *createSubscriptionUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCreateSubscriptionUseCase(h.cfg, h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type deleteSubscriptionUseCase struct {
+	db *store.Store
+}
+
+func NewDeleteSubscriptionUseCase(db *store.Store) *deleteSubscriptionUseCase {
+	return &deleteSubscriptionUseCase{
+		db: db,
+	}
+}
+
+func (uc *deleteSubscriptionUseCase) Execute(apiKey *model.APIKey, id types.ID) error {
+	if _, err := findOwnedSubscription(uc.db, apiKey, id); err != nil {
+		return err
+	}
+
+	return uc.db.WebhookSubscriptions.DeleteByID(id)
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*deleteSubscriptionHttpHandler)(nil)
+)
+
+type deleteSubscriptionHttpHandler struct {
+	db *store.Store
+
+	useCase *deleteSubscriptionUseCase
+}
+
+func NewDeleteSubscriptionHttpHandler(db *store.Store) *deleteSubscriptionHttpHandler {
+	return &deleteSubscriptionHttpHandler{
+		db: db,
+	}
+}
+
+type SubscriptionRequest struct {
+	ID types.ID `uri:"id" binding:"required"`
+}
+
+func (h *deleteSubscriptionHttpHandler) Handle(c *gin.Context) {
+	apiKey := http.APIKey(c)
+	if apiKey == nil {
+		http.Unauthorized(c, ErrAPIKeyRequired)
+		return
+	}
+
+	var req SubscriptionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	err := h.getUseCase().Execute(apiKey, req.ID)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, gin.H{"deleted": true})
+}
+
+func (h *deleteSubscriptionHttpHandler) getUseCase() *deleteSubscriptionUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeleteSubscriptionUseCase(h.db)
+	}
+	return h.useCase
+}
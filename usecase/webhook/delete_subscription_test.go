@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"testing"
+
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestDeleteSubscriptionUseCase_Execute(t *testing.T) {
+	ownerID := types.ID(1)
+	otherID := types.ID(2)
+	apiKey := &model.APIKey{Model: &model.Model{ID: ownerID}}
+
+	tests := []struct {
+		description string
+		owner       *types.ID
+		findErr     error
+		deleted     bool
+		expectedErr error
+	}{
+		{"deletes subscription of api key", &ownerID, nil, true, nil},
+		{"returns not found for subscription of other api key", &otherID, nil, false, store.ErrNotFound},
+		{"returns not found for subscription without owner", nil, nil, false, store.ErrNotFound},
+		{"returns not found for missing subscription", nil, store.ErrNotFound, false, store.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subscriptions := mock_store.NewMockWebhookSubscriptionsStore(ctrl)
+
+			subscription := &model.WebhookSubscription{Model: &model.Model{ID: types.ID(10)}, APIKeyID: tt.owner}
+			subscriptions.EXPECT().FindByID(types.ID(10)).Return(subscription, tt.findErr).Times(1)
+			if tt.deleted {
+				subscriptions.EXPECT().DeleteByID(types.ID(10)).Return(nil).Times(1)
+			}
+
+			uc := NewDeleteSubscriptionUseCase(&store.Store{WebhookSubscriptions: subscriptions})
+
+			if err := uc.Execute(apiKey, types.ID(10)); err != tt.expectedErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	dispatchBatchSize = 1000
+	retryBaseDelay    = 30 * time.Second
+	retryMaxDelay     = 6 * time.Hour
+
+	// deliveriesPerSubscription is the maximum number of deliveries of one subscription attempted in one run
+	deliveriesPerSubscription = 100
+	// dispatchConcurrency is the number of subscriptions which deliveries are sent at the same time
+	dispatchConcurrency = 8
+
+	// queueLag is how far before the watermark system events are looked up on every run,
+	// so events committed after the watermark was moved past their creation time are still queued
+	queueLag = 15 * time.Minute
+)
+
+var (
+	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+)
+
+type dispatchUseCase struct {
+	cfg *config.Config
+	db  *store.Store
+
+	client *http.Client
+}
+
+func NewDispatchUseCase(cfg *config.Config, db *store.Store) (*dispatchUseCase, error) {
+	timeout, err := time.ParseDuration(cfg.WebhookTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !cfg.WebhookAllowPrivateURLs {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   dialControl,
+		}
+		transport.DialContext = dialer.DialContext
+		// Proxy would be dialed instead of subscription URL, so its address would be checked instead of the target's
+		transport.Proxy = nil
+	}
+
+	return &dispatchUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}, nil
+}
+
+// deliveryPayload is the body of webhook request
+type deliveryPayload struct {
+	DeliveryID     types.ID          `json:"delivery_id"`
+	SubscriptionID types.ID          `json:"subscription_id"`
+	Event          model.SystemEvent `json:"event"`
+}
+
+// Execute queues new system events for subscriptions and sends pending deliveries
+func (uc *dispatchUseCase) Execute(ctx context.Context) error {
+	if err := uc.queue(); err != nil {
+		return err
+	}
+	return uc.deliver(ctx)
+}
+
+// queue creates pending deliveries for system events persisted since last run
+func (uc *dispatchUseCase) queue() error {
+	subscriptions, err := uc.db.WebhookSubscriptions.FindAll()
+	if err != nil && err != store.ErrNotFound {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		now := time.Now()
+
+		createdSince := subscription.QueuedUntil.Time.Add(-queueLag)
+		if createdSince.Before(subscription.CreatedAt.Time) {
+			createdSince = subscription.CreatedAt.Time
+		}
+
+		systemEvents, err := uc.db.SystemEvents.FindNew(store.FindNewSystemEventsQuery{
+			SubscriptionID: subscription.ID,
+			CreatedSince:   createdSince,
+			Actor:          subscription.Actor,
+			Kind:           subscription.Kind,
+			Limit:          dispatchBatchSize,
+		})
+		if err != nil && err != store.ErrNotFound {
+			return err
+		}
+
+		for _, systemEvent := range systemEvents {
+			delivery := &model.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				SystemEventID:  systemEvent.ID,
+				Status:         model.WebhookDeliveryPending,
+				NextAttemptAt:  *types.NewTimeFromTime(now),
+			}
+			if err := uc.db.WebhookDeliveries.CreateIfNotExists(delivery); err != nil {
+				return err
+			}
+		}
+
+		// Remaining events of full batch are queued in the next run
+		queuedUntil := now
+		if len(systemEvents) == dispatchBatchSize {
+			queuedUntil = systemEvents[len(systemEvents)-1].CreatedAt.Time
+		}
+		if queuedUntil.After(subscription.QueuedUntil.Time) {
+			subscription.QueuedUntil = *types.NewTimeFromTime(queuedUntil)
+			if err := uc.db.WebhookSubscriptions.Save(&subscription); err != nil {
+				return err
+			}
+		}
+
+		if len(systemEvents) > 0 {
+			logger.Info(fmt.Sprintf("queued webhook deliveries [subscription=%d] [count=%d]", subscription.ID, len(systemEvents)))
+		}
+	}
+	return nil
+}
+
+// deliver sends pending deliveries which are due. Subscriptions are delivered concurrently,
+// so slow or failing endpoint of one subscription does not hold back deliveries of others
+func (uc *dispatchUseCase) deliver(ctx context.Context) error {
+	deliveries, err := uc.db.WebhookDeliveries.FindPending(time.Now(), deliveriesPerSubscription, dispatchBatchSize)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	var subscriptionIDs []types.ID
+	bySubscription := map[types.ID][]model.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		if _, ok := bySubscription[delivery.SubscriptionID]; !ok {
+			subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+		}
+		bySubscription[delivery.SubscriptionID] = append(bySubscription[delivery.SubscriptionID], delivery)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, dispatchConcurrency)
+	errs := make(chan error, len(subscriptionIDs))
+	for _, subscriptionID := range subscriptionIDs {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(subscriptionID types.ID, deliveries []model.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := uc.deliverSubscription(ctx, subscriptionID, deliveries); err != nil {
+				errs <- err
+			}
+		}(subscriptionID, bySubscription[subscriptionID])
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// deliverSubscription sends deliveries of single subscription in order.
+// After the first failed attempt remaining deliveries are left for the next run, so failing endpoint costs at most one timeout per run
+func (uc *dispatchUseCase) deliverSubscription(ctx context.Context, subscriptionID types.ID, deliveries []model.WebhookDelivery) error {
+	subscription, err := uc.db.WebhookSubscriptions.FindByID(subscriptionID)
+	if err != nil {
+		if err == store.ErrNotFound {
+			// Subscription has been deleted in the meantime together with its deliveries
+			return nil
+		}
+		return err
+	}
+
+	for i := range deliveries {
+		ok, err := uc.attempt(ctx, subscription, &deliveries[i])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			logger.Info(fmt.Sprintf("postponing webhook deliveries [subscription=%d] [count=%d]", subscription.ID, len(deliveries)-i-1))
+			return nil
+		}
+	}
+	return nil
+}
+
+// attempt sends single delivery and records the outcome in delivery log.
+// It returns false when endpoint of subscription failed to accept the delivery
+func (uc *dispatchUseCase) attempt(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) (bool, error) {
+	systemEvent, err := uc.db.SystemEvents.FindByID(delivery.SystemEventID)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return false, err
+		}
+		// System event has been purged, there is nothing to deliver anymore
+		delivery.Fail(nil, err, nil)
+		return true, uc.db.WebhookDeliveries.Save(delivery)
+	}
+
+	body, err := json.Marshal(deliveryPayload{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		Event:          *systemEvent,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	statusCode, err := uc.send(ctx, subscription, delivery.ID, body)
+	ok := err == nil
+	if ok {
+		delivery.Succeed(*statusCode)
+		logger.Info(fmt.Sprintf("webhook delivered [subscription=%d] [delivery=%d]", subscription.ID, delivery.ID))
+	} else {
+		nextAttemptAt := nextAttemptTime(delivery.Attempts+1, uc.cfg.WebhookMaxAttempts, time.Now())
+		delivery.Fail(statusCode, err, nextAttemptAt)
+		logger.Info(fmt.Sprintf("webhook delivery failed [subscription=%d] [delivery=%d] [attempts=%d] [err=%s]", subscription.ID, delivery.ID, delivery.Attempts, err))
+	}
+
+	return ok, uc.db.WebhookDeliveries.Save(delivery)
+}
+
+// send posts signed body to subscription URL. Status code is returned whenever response was received
+func (uc *dispatchUseCase) send(ctx context.Context, subscription *model.WebhookSubscription, deliveryID types.ID, body []byte) (*int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(int64(deliveryID), 10))
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, body))
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	statusCode := int64(resp.StatusCode)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &statusCode, errors.Wrapf(ErrUnexpectedStatusCode, "%d", resp.StatusCode)
+	}
+	return &statusCode, nil
+}
+
+// Sign returns signature of webhook request, HMAC-SHA256 of timestamp and body joined with a dot
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// nextAttemptTime returns time of the next attempt with exponential backoff, or nil when attempts are exhausted
+func nextAttemptTime(attempts int64, maxAttempts int64, now time.Time) *time.Time {
+	if attempts >= maxAttempts {
+		return nil
+	}
+
+	delay := time.Duration(float64(retryBaseDelay) * math.Pow(2, float64(attempts-1)))
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+
+	next := now.Add(delay)
+	return &next
+}
@@ -0,0 +1,250 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock_store "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestDispatchUseCase_send(t *testing.T) {
+	tests := []struct {
+		description string
+		statusCode  int
+		expectedErr bool
+	}{
+		{"returns no error for successful response", http.StatusOK, false},
+		{"returns error for unsuccessful response", http.StatusInternalServerError, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			body := []byte(`{"delivery_id":1}`)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ := ioutil.ReadAll(r.Body)
+				if string(received) != string(body) {
+					t.Errorf("unexpected body, want %s; got %s", body, received)
+				}
+
+				expectedSignature := Sign("secret", r.Header.Get(TimestampHeader), body)
+				if r.Header.Get(SignatureHeader) != expectedSignature {
+					t.Errorf("unexpected signature, want %s; got %s", expectedSignature, r.Header.Get(SignatureHeader))
+				}
+				if r.Header.Get(DeliveryHeader) != "7" {
+					t.Errorf("unexpected delivery id, want 7; got %s", r.Header.Get(DeliveryHeader))
+				}
+
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			uc, err := NewDispatchUseCase(&config.Config{WebhookTimeout: "1s", WebhookAllowPrivateURLs: true}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			statusCode, err := uc.send(context.Background(), &model.WebhookSubscription{URL: server.URL, Secret: "secret"}, 7, body)
+			if tt.expectedErr != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if statusCode == nil || *statusCode != int64(tt.statusCode) {
+				t.Errorf("unexpected status code, want %d; got %v", tt.statusCode, statusCode)
+			}
+		})
+	}
+}
+
+func TestDispatchUseCase_send_privateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request to loopback address should not be sent")
+	}))
+	defer server.Close()
+
+	uc, err := NewDispatchUseCase(&config.Config{WebhookTimeout: "1s"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	statusCode, err := uc.send(context.Background(), &model.WebhookSubscription{URL: server.URL, Secret: "secret"}, 7, []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), ErrForbiddenAddress.Error()) {
+		t.Errorf("unexpected error, want %v; got %v", ErrForbiddenAddress, err)
+	}
+	if statusCode != nil {
+		t.Errorf("unexpected status code %d", *statusCode)
+	}
+}
+
+func TestDispatchUseCase_queue(t *testing.T) {
+	createdAt := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+	queuedUntil := time.Date(2020, 8, 2, 10, 0, 0, 0, time.UTC)
+
+	t.Run("looks up events created since watermark minus lag and moves watermark", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptions := mock_store.NewMockWebhookSubscriptionsStore(ctrl)
+		systemEvents := mock_store.NewMockSystemEventsStore(ctrl)
+		deliveries := mock_store.NewMockWebhookDeliveriesStore(ctrl)
+
+		subscription := model.WebhookSubscription{
+			Model:       &model.Model{ID: types.ID(1), CreatedAt: *types.NewTimeFromTime(createdAt)},
+			QueuedUntil: *types.NewTimeFromTime(queuedUntil),
+		}
+		subscriptions.EXPECT().FindAll().Return([]model.WebhookSubscription{subscription}, nil).Times(1)
+
+		// Event with id lower than already queued events, committed late
+		lateEvent := model.SystemEvent{Model: &model.Model{ID: types.ID(5), CreatedAt: *types.NewTimeFromTime(queuedUntil.Add(-time.Minute))}}
+		systemEvents.EXPECT().FindNew(store.FindNewSystemEventsQuery{
+			SubscriptionID: types.ID(1),
+			CreatedSince:   queuedUntil.Add(-queueLag),
+			Limit:          dispatchBatchSize,
+		}).Return([]model.SystemEvent{lateEvent}, nil).Times(1)
+
+		deliveries.EXPECT().CreateIfNotExists(gomock.Any()).DoAndReturn(func(delivery *model.WebhookDelivery) error {
+			if delivery.SystemEventID != lateEvent.ID {
+				t.Errorf("unexpected system event, want %d; got %d", lateEvent.ID, delivery.SystemEventID)
+			}
+			return nil
+		}).Times(1)
+
+		subscriptions.EXPECT().Save(gomock.Any()).DoAndReturn(func(val interface{}) error {
+			saved := val.(*model.WebhookSubscription)
+			if !saved.QueuedUntil.Time.After(queuedUntil) {
+				t.Errorf("watermark was not moved, got %s", saved.QueuedUntil)
+			}
+			return nil
+		}).Times(1)
+
+		uc := &dispatchUseCase{db: &store.Store{WebhookSubscriptions: subscriptions, SystemEvents: systemEvents, WebhookDeliveries: deliveries}}
+		if err := uc.queue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does not look up events created before subscription", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptions := mock_store.NewMockWebhookSubscriptionsStore(ctrl)
+		systemEvents := mock_store.NewMockSystemEventsStore(ctrl)
+
+		subscription := model.WebhookSubscription{
+			Model:       &model.Model{ID: types.ID(1), CreatedAt: *types.NewTimeFromTime(queuedUntil)},
+			QueuedUntil: *types.NewTimeFromTime(queuedUntil),
+		}
+		subscriptions.EXPECT().FindAll().Return([]model.WebhookSubscription{subscription}, nil).Times(1)
+		systemEvents.EXPECT().FindNew(store.FindNewSystemEventsQuery{
+			SubscriptionID: types.ID(1),
+			CreatedSince:   queuedUntil,
+			Limit:          dispatchBatchSize,
+		}).Return(nil, nil).Times(1)
+		subscriptions.EXPECT().Save(gomock.Any()).Return(nil).Times(1)
+
+		uc := &dispatchUseCase{db: &store.Store{WebhookSubscriptions: subscriptions, SystemEvents: systemEvents}}
+		if err := uc.queue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestDispatchUseCase_deliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(DeliveryHeader) != "11" {
+			t.Errorf("delivery %s should be postponed after failed attempt", r.Header.Get(DeliveryHeader))
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	succeeding := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer succeeding.Close()
+
+	subscriptions := mock_store.NewMockWebhookSubscriptionsStore(ctrl)
+	systemEvents := mock_store.NewMockSystemEventsStore(ctrl)
+	deliveries := mock_store.NewMockWebhookDeliveriesStore(ctrl)
+
+	newDelivery := func(id, subscriptionID int64) model.WebhookDelivery {
+		return model.WebhookDelivery{Model: &model.Model{ID: types.ID(id)}, SubscriptionID: types.ID(subscriptionID), SystemEventID: types.ID(1), Status: model.WebhookDeliveryPending}
+	}
+	deliveries.EXPECT().FindPending(gomock.Any(), int64(deliveriesPerSubscription), int64(dispatchBatchSize)).Return([]model.WebhookDelivery{
+		newDelivery(11, 1), newDelivery(21, 2), newDelivery(12, 1),
+	}, nil).Times(1)
+	subscriptions.EXPECT().FindByID(types.ID(1)).Return(&model.WebhookSubscription{Model: &model.Model{ID: types.ID(1)}, URL: failing.URL, Secret: "secret"}, nil).Times(1)
+	subscriptions.EXPECT().FindByID(types.ID(2)).Return(&model.WebhookSubscription{Model: &model.Model{ID: types.ID(2)}, URL: succeeding.URL, Secret: "secret"}, nil).Times(1)
+	systemEvents.EXPECT().FindByID(types.ID(1)).Return(&model.SystemEvent{Model: &model.Model{ID: types.ID(1)}}, nil).Times(2)
+
+	saved := map[types.ID]model.WebhookDeliveryStatus{}
+	var mu sync.Mutex
+	deliveries.EXPECT().Save(gomock.Any()).DoAndReturn(func(val interface{}) error {
+		delivery := val.(*model.WebhookDelivery)
+		mu.Lock()
+		saved[delivery.ID] = delivery.Status
+		mu.Unlock()
+		return nil
+	}).Times(2)
+
+	uc, err := NewDispatchUseCase(&config.Config{WebhookTimeout: "1s", WebhookMaxAttempts: 3, WebhookAllowPrivateURLs: true}, &store.Store{WebhookSubscriptions: subscriptions, SystemEvents: systemEvents, WebhookDeliveries: deliveries})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := uc.deliver(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if saved[types.ID(11)] != model.WebhookDeliveryPending {
+		t.Errorf("unexpected status of failed delivery, want %s; got %s", model.WebhookDeliveryPending, saved[types.ID(11)])
+	}
+	if saved[types.ID(21)] != model.WebhookDeliveryDelivered {
+		t.Errorf("unexpected status of delivery of other subscription, want %s; got %s", model.WebhookDeliveryDelivered, saved[types.ID(21)])
+	}
+	if _, ok := saved[types.ID(12)]; ok {
+		t.Errorf("delivery after failed attempt should not be attempted")
+	}
+}
+
+func TestNextAttemptTime(t *testing.T) {
+	now := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		attempts      int64
+		expectedDelay time.Duration
+		exhausted     bool
+	}{
+		{1, 30 * time.Second, false},
+		{2, time.Minute, false},
+		{4, 4 * time.Minute, false},
+		{7, 32 * time.Minute, false},
+		{8, 0, true},
+	}
+
+	for _, tt := range tests {
+		next := nextAttemptTime(tt.attempts, 8, now)
+		if tt.exhausted {
+			if next != nil {
+				t.Errorf("attempts %d: expected no next attempt; got %s", tt.attempts, next)
+			}
+			continue
+		}
+		if next == nil || next.Sub(now) != tt.expectedDelay {
+			t.Errorf("attempts %d: unexpected delay, want %s; got %v", tt.attempts, tt.expectedDelay, next)
+		}
+	}
+}
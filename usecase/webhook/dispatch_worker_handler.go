@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*dispatchWorkerHandler)(nil)
+)
+
+type dispatchWorkerHandler struct {
+	cfg *config.Config
+	db  *store.Store
+
+	useCase *dispatchUseCase
+}
+
+func NewDispatchWorkerHandler(cfg *config.Config, db *store.Store) *dispatchWorkerHandler {
+	return &dispatchWorkerHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+func (h *dispatchWorkerHandler) Handle() {
+	ctx := context.Background()
+
+	logger.Info("running webhook dispatch use case [handler=worker]")
+
+	uc, err := h.getUseCase()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	if err := uc.Execute(ctx); err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *dispatchWorkerHandler) getUseCase() (*dispatchUseCase, error) {
+	if h.useCase == nil {
+		uc, err := NewDispatchUseCase(h.cfg, h.db)
+		if err != nil {
+			return nil, err
+		}
+		h.useCase = uc
+	}
+	return h.useCase, nil
+}
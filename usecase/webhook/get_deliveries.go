@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+type getDeliveriesUseCase struct {
+	db *store.Store
+}
+
+func NewGetDeliveriesUseCase(db *store.Store) *getDeliveriesUseCase {
+	return &getDeliveriesUseCase{
+		db: db,
+	}
+}
+
+func (uc *getDeliveriesUseCase) Execute(apiKey *model.APIKey, subscriptionID types.ID, page store.Pagination) (*DeliveryListView, error) {
+	if _, err := findOwnedSubscription(uc.db, apiKey, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.db.WebhookDeliveries.FindBySubscription(subscriptionID, page)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor *store.Cursor
+	if len(deliveries) > 0 {
+		last := deliveries[len(deliveries)-1]
+		nextCursor = page.NextCursor(len(deliveries), int64(last.SystemEventID), last.ID)
+	}
+
+	return ToDeliveryListView(deliveries, http.EncodeCursor(nextCursor)), nil
+}
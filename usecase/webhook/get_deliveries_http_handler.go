@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getDeliveriesHttpHandler)(nil)
+)
+
+type getDeliveriesHttpHandler struct {
+	db *store.Store
+
+	useCase *getDeliveriesUseCase
+}
+
+func NewGetDeliveriesHttpHandler(db *store.Store) *getDeliveriesHttpHandler {
+	return &getDeliveriesHttpHandler{
+		db: db,
+	}
+}
+
+type GetDeliveriesRequest struct {
+	http.PaginationRequest
+}
+
+func (h *getDeliveriesHttpHandler) Handle(c *gin.Context) {
+	apiKey := http.APIKey(c)
+	if apiKey == nil {
+		http.Unauthorized(c, ErrAPIKeyRequired)
+		return
+	}
+
+	var uriReq SubscriptionRequest
+	if err := c.ShouldBindUri(&uriReq); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	var req GetDeliveriesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid pagination parameters"))
+		return
+	}
+
+	page, err := req.ToPagination()
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(apiKey, uriReq.ID, *page)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getDeliveriesHttpHandler) getUseCase() *getDeliveriesUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetDeliveriesUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type getSubscriptionsUseCase struct {
+	db *store.Store
+}
+
+func NewGetSubscriptionsUseCase(db *store.Store) *getSubscriptionsUseCase {
+	return &getSubscriptionsUseCase{
+		db: db,
+	}
+}
+
+func (uc *getSubscriptionsUseCase) Execute(apiKey *model.APIKey) (*SubscriptionListView, error) {
+	subscriptions, err := uc.db.WebhookSubscriptions.FindByAPIKey(apiKey.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSubscriptionListView(subscriptions), nil
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getSubscriptionsHttpHandler)(nil)
+)
+
+type getSubscriptionsHttpHandler struct {
+	db *store.Store
+
+	useCase *getSubscriptionsUseCase
+}
+
+func NewGetSubscriptionsHttpHandler(db *store.Store) *getSubscriptionsHttpHandler {
+	return &getSubscriptionsHttpHandler{
+		db: db,
+	}
+}
+
+func (h *getSubscriptionsHttpHandler) Handle(c *gin.Context) {
+	apiKey := http.APIKey(c)
+	if apiKey == nil {
+		http.Unauthorized(c, ErrAPIKeyRequired)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(apiKey)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getSubscriptionsHttpHandler) getUseCase() *getSubscriptionsUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSubscriptionsUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAPIKeyRequired = errors.New("webhook subscriptions require api key")
+)
+
+// findOwnedSubscription returns subscription created by api key.
+// Subscriptions of other api keys are reported as not found
+func findOwnedSubscription(db *store.Store, apiKey *model.APIKey, id types.ID) (*model.WebhookSubscription, error) {
+	subscription, err := db.WebhookSubscriptions.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.APIKeyID == nil || *subscription.APIKeyID != apiKey.ID {
+		return nil, store.ErrNotFound
+	}
+	return subscription, nil
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+)
+
+type SubscriptionListView struct {
+	Items []model.WebhookSubscription `json:"items"`
+}
+
+func ToSubscriptionListView(subscriptions []model.WebhookSubscription) *SubscriptionListView {
+	return &SubscriptionListView{
+		Items: subscriptions,
+	}
+}
+
+// SubscriptionCreatedView includes secret, which is not returned anywhere else
+type SubscriptionCreatedView struct {
+	*model.WebhookSubscription
+
+	Secret string `json:"secret"`
+}
+
+func ToSubscriptionCreatedView(subscription *model.WebhookSubscription) *SubscriptionCreatedView {
+	return &SubscriptionCreatedView{
+		WebhookSubscription: subscription,
+		Secret:              subscription.Secret,
+	}
+}
+
+type DeliveryListView struct {
+	Items      []model.WebhookDelivery `json:"items"`
+	NextCursor *string                 `json:"next_cursor"`
+}
+
+func (v DeliveryListView) PageItems() interface{} {
+	return v.Items
+}
+
+func (v DeliveryListView) PageNextCursor() *string {
+	return v.NextCursor
+}
+
+func ToDeliveryListView(deliveries []model.WebhookDelivery, nextCursor *string) *DeliveryListView {
+	return &DeliveryListView{
+		Items:      deliveries,
+		NextCursor: nextCursor,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/figment-networks/oasishub-indexer/usecase/indexing"
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
 )
 
 func NewWorkerHandlers(cfg *config.Config, db *store.Store, c *client.Client) *WorkerHandlers {
@@ -13,6 +14,7 @@ func NewWorkerHandlers(cfg *config.Config, db *store.Store, c *client.Client) *W
 		IndexerIndex:     indexing.NewIndexWorkerHandler(cfg, db, c),
 		IndexerSummarize: indexing.NewSummarizeWorkerHandler(cfg, db, c),
 		IndexerPurge:     indexing.NewPurgeWorkerHandler(cfg, db, c),
+		WebhookDispatch:  webhook.NewDispatchWorkerHandler(cfg, db),
 	}
 }
 
@@ -20,4 +22,5 @@ type WorkerHandlers struct {
 	IndexerIndex     types.WorkerHandler
 	IndexerSummarize types.WorkerHandler
 	IndexerPurge     types.WorkerHandler
+	WebhookDispatch  types.WorkerHandler
 }
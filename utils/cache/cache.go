@@ -0,0 +1,24 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+)
+
+// Cache stores values by key for given time
+type Cache interface {
+	// Get returns value of key and false when key is missing or expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Close() error
+}
+
+// New returns Redis cache when Redis url is configured, otherwise in-memory LRU cache
+func New(cfg *config.Config) (Cache, error) {
+	if cfg.CacheRedisUrl != "" {
+		return NewRedis(cfg.CacheRedisUrl)
+	}
+	return NewLRU(int(cfg.CacheMaxBytes)), nil
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	_ Cache = (*lru)(nil)
+
+	now = time.Now
+)
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func (e *lruEntry) bytes() int {
+	return len(e.key) + len(e.value)
+}
+
+// lru is in-memory cache which evicts least recently used entries when keys and values take more than maxBytes
+type lru struct {
+	mu sync.Mutex
+
+	maxBytes int
+	bytes    int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+func NewLRU(maxBytes int) *lru {
+	return &lru{
+		maxBytes: maxBytes,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (c *lru) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := el.Value.(*lruEntry)
+	if now().After(entry.expiresAt) {
+		c.remove(el)
+		return nil, false, nil
+	}
+
+	c.order.MoveToFront(el)
+	return entry.value, true, nil
+}
+
+func (c *lru) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+
+	entry := &lruEntry{key: key, value: value, expiresAt: now().Add(ttl)}
+	// Entry which would not fit even into empty cache is not stored
+	if entry.bytes() > c.maxBytes {
+		return nil
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	c.bytes += entry.bytes()
+	for c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+func (c *lru) Close() error {
+	return nil
+}
+
+func (c *lru) remove(el *list.Element) {
+	entry := el.Value.(*lruEntry)
+	c.order.Remove(el)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.bytes()
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("evicts least recently used entry", func(t *testing.T) {
+		c := NewLRU(4)
+		c.Set(ctx, "a", []byte("1"), time.Minute)
+		c.Set(ctx, "b", []byte("2"), time.Minute)
+		c.Get(ctx, "a")
+		c.Set(ctx, "c", []byte("3"), time.Minute)
+
+		if _, ok, _ := c.Get(ctx, "b"); ok {
+			t.Error("expected b to be evicted")
+		}
+		for _, key := range []string{"a", "c"} {
+			if _, ok, _ := c.Get(ctx, key); !ok {
+				t.Errorf("expected %s to be cached", key)
+			}
+		}
+	})
+
+	t.Run("expires entry", func(t *testing.T) {
+		current := time.Now()
+		now = func() time.Time { return current }
+		defer func() { now = time.Now }()
+
+		c := NewLRU(4)
+		c.Set(ctx, "a", []byte("1"), time.Second)
+
+		if value, ok, _ := c.Get(ctx, "a"); !ok || string(value) != "1" {
+			t.Errorf("unexpected value: %s", value)
+		}
+
+		current = current.Add(2 * time.Second)
+		if _, ok, _ := c.Get(ctx, "a"); ok {
+			t.Error("expected a to be expired")
+		}
+		if len(c.entries) != 0 || c.bytes != 0 {
+			t.Errorf("unexpected entries count: %d, bytes: %d", len(c.entries), c.bytes)
+		}
+	})
+
+	t.Run("evicts entries until values fit", func(t *testing.T) {
+		c := NewLRU(10)
+		c.Set(ctx, "a", []byte("1234"), time.Minute)
+		c.Set(ctx, "b", []byte("1234"), time.Minute)
+		c.Set(ctx, "a", []byte("12345678"), time.Minute)
+
+		if _, ok, _ := c.Get(ctx, "b"); ok {
+			t.Error("expected b to be evicted")
+		}
+		if value, ok, _ := c.Get(ctx, "a"); !ok || string(value) != "12345678" {
+			t.Errorf("unexpected value: %s", value)
+		}
+		if c.bytes != 9 {
+			t.Errorf("unexpected bytes, want %d; got %d", 9, c.bytes)
+		}
+	})
+
+	t.Run("does not store entry larger than cache", func(t *testing.T) {
+		c := NewLRU(4)
+		c.Set(ctx, "a", []byte("1"), time.Minute)
+		c.Set(ctx, "b", []byte("12345"), time.Minute)
+
+		if _, ok, _ := c.Get(ctx, "b"); ok {
+			t.Error("expected b not to be cached")
+		}
+		if _, ok, _ := c.Get(ctx, "a"); !ok {
+			t.Error("expected a to be cached")
+		}
+	})
+
+	t.Run("zero size disables cache", func(t *testing.T) {
+		c := NewLRU(0)
+		c.Set(ctx, "a", []byte("1"), time.Minute)
+
+		if _, ok, _ := c.Get(ctx, "a"); ok {
+			t.Error("expected a not to be cached")
+		}
+	})
+}
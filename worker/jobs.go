@@ -19,3 +19,9 @@ func (w *Worker) addIndexerPurgeJob() (cron.EntryID, error) {
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.PurgeWorkerInterval, job)
 }
+
+func (w *Worker) addWebhookDispatchJob() (cron.EntryID, error) {
+	job = cron.FuncJob(w.handlers.WebhookDispatch.Handle)
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.DispatchWorkerInterval, job)
+}
@@ -55,6 +55,11 @@ func (w *Worker) init() (*Worker, error) {
 		return nil, err
 	}
 
+	_, err = w.addWebhookDispatchJob()
+	if err != nil {
+		return nil, err
+	}
+
 	return w, nil
 }
 